@@ -0,0 +1,68 @@
+package main
+
+import (
+	"os"
+	"unicode/utf8"
+)
+
+// ANSI escape codes used by -color.
+const (
+	ansiGreen = "\x1b[32m"
+	ansiRed   = "\x1b[31m"
+	ansiReset = "\x1b[0m"
+)
+
+// formDiff splits two forms into the prefix and suffix they share and the
+// differing middle of each: czytam and czytuję share czyt, differ in am
+// and uję, and share no suffix. Splits fall on rune boundaries, and the
+// prefix and suffix never overlap.
+type formDiff struct {
+	Prefix, Want, Got, Suffix string
+}
+
+// diffForms compares an expected form with the one a heuristic produced.
+func diffForms(want, got string) formDiff {
+	p := 0
+	for p < len(want) && p < len(got) {
+		rw, nw := utf8.DecodeRuneInString(want[p:])
+		rg, ng := utf8.DecodeRuneInString(got[p:])
+		if rw != rg || nw != ng {
+			break
+		}
+		p += nw
+	}
+	s := 0
+	for s < len(want)-p && s < len(got)-p {
+		rw, nw := utf8.DecodeLastRuneInString(want[:len(want)-s])
+		rg, ng := utf8.DecodeLastRuneInString(got[:len(got)-s])
+		if rw != rg || nw != ng || s+nw > len(want)-p || s+ng > len(got)-p {
+			break
+		}
+		s += nw
+	}
+	return formDiff{
+		Prefix: want[:p],
+		Want:   want[p : len(want)-s],
+		Got:    got[p : len(got)-s],
+		Suffix: want[len(want)-s:],
+	}
+}
+
+// highlight renders one side of a diff, the shared parts in green and the
+// differing middle in red.
+func highlight(d formDiff, middle string) string {
+	out := ansiGreen + d.Prefix + ansiReset
+	if middle != "" {
+		out += ansiRed + middle + ansiReset
+	}
+	if d.Suffix != "" {
+		out += ansiGreen + d.Suffix + ansiReset
+	}
+	return out
+}
+
+// isTerminal reports whether f is a terminal rather than a pipe or file.
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func TestDiffForms(t *testing.T) {
+	tests := []struct {
+		want, got string
+		diff      formDiff
+	}{
+		{"czytam", "czytam", formDiff{Prefix: "czytam"}},
+		{"czytam", "czytuję", formDiff{Prefix: "czyt", Want: "am", Got: "uję"}},
+		{"piszę", "pisę", formDiff{Prefix: "pis", Want: "z", Suffix: "ę"}},
+		{"niosę", "nieśę", formDiff{Prefix: "ni", Want: "os", Got: "eś", Suffix: "ę"}},
+		{"mogę", "może", formDiff{Prefix: "mo", Want: "gę", Got: "że"}},
+		{"aa", "aaa", formDiff{Prefix: "aa", Got: "a"}},
+		{"", "robię", formDiff{Got: "robię"}},
+		{"ją", "ę", formDiff{Want: "ją", Got: "ę"}},
+	}
+	for _, tt := range tests {
+		d := diffForms(tt.want, tt.got)
+		if d != tt.diff {
+			t.Errorf("diffForms(%q, %q) = %+v, want %+v", tt.want, tt.got, d, tt.diff)
+		}
+		if w := d.Prefix + d.Want + d.Suffix; w != tt.want {
+			t.Errorf("diffForms(%q, %q) rebuilds want as %q", tt.want, tt.got, w)
+		}
+		if g := d.Prefix + d.Got + d.Suffix; g != tt.got {
+			t.Errorf("diffForms(%q, %q) rebuilds got as %q", tt.want, tt.got, g)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	d := diffForms("piszę", "pisę")
+	if got, want := highlight(d, d.Want), "\x1b[32mpis\x1b[0m\x1b[31mz\x1b[0m\x1b[32mę\x1b[0m"; got != want {
+		t.Errorf("highlight(want) = %q, want %q", got, want)
+	}
+	if got, want := highlight(d, d.Got), "\x1b[32mpis\x1b[0m\x1b[32mę\x1b[0m"; got != want {
+		t.Errorf("highlight(got) = %q, want %q", got, want)
+	}
+}
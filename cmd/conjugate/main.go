@@ -2,9 +2,11 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
 	"os"
 	"strings"
+	"unicode/utf8"
 
 	"petezalew.ski/odmiany/pkg/verb"
 )
@@ -19,9 +21,17 @@ type corpusEntry struct {
 	Pl3        string `json:"pl3"`
 }
 
+// color highlights where got and expected forms differ. It is set by
+// -color and only when stdout is a terminal.
+var color bool
+
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: conjugate <prefix|infinitive>")
+	colorFlag := flag.Bool("color", false, "highlight differing characters when stdout is a terminal")
+	flag.Parse()
+	color = *colorFlag && isTerminal(os.Stdout)
+
+	if flag.NArg() < 1 {
+		fmt.Println("Usage: conjugate [-color] <prefix|infinitive>")
 		fmt.Println("  Search corpus for verbs matching prefix and show conjugations")
 		fmt.Println("  If exact infinitive given, shows detailed comparison")
 		os.Exit(1)
@@ -47,7 +57,7 @@ func main() {
 	}
 
 	// Process each argument
-	for i, query := range os.Args[1:] {
+	for i, query := range flag.Args() {
 		if i > 0 {
 			fmt.Println()
 		}
@@ -155,6 +165,11 @@ func showComparison(e corpusEntry) {
 
 	if err != nil {
 		fmt.Printf("%-20s %s (want: %s)\n", e.Infinitive, status, e.Sg1)
+	} else if color {
+		d := diffForms(e.Sg1, paradigms[0].Sg1)
+		pad := max(15-utf8.RuneCountInString(paradigms[0].Sg1), 0)
+		fmt.Printf("%-20s %s got=%s%s want=%s\n", e.Infinitive, status,
+			highlight(d, d.Got), strings.Repeat(" ", pad), highlight(d, d.Want))
 	} else {
 		fmt.Printf("%-20s %s got=%-15s want=%s\n", e.Infinitive, status, paradigms[0].Sg1, e.Sg1)
 	}
@@ -180,7 +195,13 @@ func printParadigm(p verb.PresentTense) {
 
 func compare(form, expected, got string) {
 	if expected == got {
+		if color {
+			got = ansiGreen + got + ansiReset
+		}
 		fmt.Printf("  %s: ✓ %s\n", form, got)
+	} else if color {
+		d := diffForms(expected, got)
+		fmt.Printf("  %s: ✗ got \"%s\", want \"%s\"\n", form, highlight(d, d.Got), highlight(d, d.Want))
 	} else {
 		fmt.Printf("  %s: ✗ got %q, want %q\n", form, got, expected)
 	}
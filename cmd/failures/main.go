@@ -3,8 +3,11 @@ package main
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -23,15 +26,22 @@ type corpusEntry struct {
 }
 
 type failure struct {
-	Infinitive  string
-	Freq        int
-	Got         string
-	Want        string
-	NoMatch     bool
-	WrongForms  []string // which specific forms are wrong
+	Infinitive string          `json:"infinitive"`
+	Freq       int             `json:"freq"`
+	Status     string          `json:"status"` // WRONG or NO_MATCH
+	Got        string          `json:"got"`
+	Want       string          `json:"want"`
+	NoMatch    bool            `json:"-"`
+	WrongForms []string        `json:"wrongForms,omitempty"` // which specific forms are wrong
+	Confidence verb.Confidence `json:"-"`
+	ConfName   string          `json:"confidence,omitempty"` // Confidence, set for WRONG only
 }
 
 func main() {
+	jsonOut := flag.Bool("json", false, "print one JSON record per failure instead of the text report")
+	minFreq := flag.Int("min-freq", 0, "leave out verbs less frequent than this")
+	flag.Parse()
+
 	// Load frequency data from OpenSubtitles (hermitdave/FrequencyWords)
 	freqMap := loadFrequency("pkg/verb/testdata/pl_freq.txt")
 
@@ -49,8 +59,12 @@ func main() {
 	}
 
 	var failures []failure
+	irregular := 0
 
 	for _, e := range entries {
+		if present, _, _ := verb.IsIrregular(e.Infinitive); present {
+			irregular++
+		}
 		expected := verb.PresentTense{
 			Sg1: e.Sg1, Sg2: e.Sg2, Sg3: e.Sg3,
 			Pl1: e.Pl1, Pl2: e.Pl2, Pl3: e.Pl3,
@@ -62,13 +76,19 @@ func main() {
 		freq := getVerbFrequency(freqMap, e)
 
 		if err != nil {
-			failures = append(failures, failure{
+			f := failure{
 				Infinitive: e.Infinitive,
 				Freq:       freq,
-				Got:        "",
+				Status:     "WRONG",
 				Want:       e.Sg1,
-				NoMatch:    true,
-			})
+				NoMatch:    errors.Is(err, verb.ErrNoMatch),
+			}
+			if f.NoMatch {
+				f.Status = "NO_MATCH"
+			} else {
+				f.Got = err.Error()
+			}
+			failures = append(failures, f)
 			continue
 		}
 
@@ -92,56 +112,63 @@ func main() {
 		failures = append(failures, failure{
 			Infinitive: e.Infinitive,
 			Freq:       freq,
+			Status:     "WRONG",
 			Got:        bestParadigm.Sg1,
 			Want:       e.Sg1,
 			NoMatch:    false,
 			WrongForms: wrongForms,
+			Confidence: paradigms[0].Confidence,
+			ConfName:   paradigms[0].Confidence.String(),
 		})
 	}
 
+	// Drop rare verbs
+	failures = slices.DeleteFunc(failures, func(f failure) bool {
+		return f.Freq < *minFreq
+	})
+
 	// Sort by frequency (descending)
 	sort.Slice(failures, func(i, j int) bool {
 		return failures[i].Freq > failures[j].Freq
 	})
 
+	if *jsonOut {
+		// One record per line, so reports diff cleanly across commits
+		enc := json.NewEncoder(os.Stdout)
+		for _, f := range failures {
+			if err := enc.Encode(f); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing JSON: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		fmt.Fprintf(os.Stderr, "Total failures: %d\n", len(failures))
+		return
+	}
+
 	// Print results
 	for _, f := range failures {
-		status := "WRONG"
-		if f.NoMatch {
-			status = "NO_MATCH"
-		}
 		wrongInfo := ""
 		if len(f.WrongForms) > 0 {
 			wrongInfo = fmt.Sprintf(" [%s]", strings.Join(f.WrongForms, ","))
 		}
-		fmt.Printf("%-20s freq=%9d  %-10s got=%-15s want=%s%s\n",
-			f.Infinitive, f.Freq, status, f.Got, f.Want, wrongInfo)
+		conf := ""
+		if !f.NoMatch {
+			conf = " conf=" + f.Confidence.String()
+		}
+		fmt.Printf("%-20s freq=%9d  %-10s got=%-15s want=%s%s%s\n",
+			f.Infinitive, f.Freq, f.Status, f.Got, f.Want, wrongInfo, conf)
 	}
 
 	fmt.Fprintf(os.Stderr, "\nTotal failures: %d\n", len(failures))
+	fmt.Fprintf(os.Stderr, "Coverage: %d irregulars covered, %d derived\n", irregular, len(entries)-irregular)
 	fmt.Fprintf(os.Stderr, "Frequency source: OpenSubtitles 2018 (hermitdave/FrequencyWords)\n")
 }
 
 // compareParadigms returns a list of form names that differ
 func compareParadigms(expected, got verb.PresentTense) []string {
 	var wrong []string
-	if expected.Sg1 != got.Sg1 {
-		wrong = append(wrong, "1sg")
-	}
-	if expected.Sg2 != got.Sg2 {
-		wrong = append(wrong, "2sg")
-	}
-	if expected.Sg3 != got.Sg3 {
-		wrong = append(wrong, "3sg")
-	}
-	if expected.Pl1 != got.Pl1 {
-		wrong = append(wrong, "1pl")
-	}
-	if expected.Pl2 != got.Pl2 {
-		wrong = append(wrong, "2pl")
-	}
-	if expected.Pl3 != got.Pl3 {
-		wrong = append(wrong, "3pl")
+	for _, d := range expected.Diff(got) {
+		wrong = append(wrong, d.Slot.String())
 	}
 	return wrong
 }
@@ -0,0 +1,95 @@
+// Package main regenerates every test corpus from Polimorf in one invocation.
+//
+// It reads the Polimorf dump once and writes the present, past, verbal
+// noun, imperative, participle and gerund corpora to the testdata
+// directory:
+//
+//	go run ./cmd/gencorpora -input data/polish.txt.bz2 -out pkg/verb/testdata
+//
+// The extraction is shared with cmd/genverbs through internal/polimorf, and
+// so is -exclude-qualifiers for the 5-column SGJP dump.
+package main
+
+import (
+	"compress/bzip2"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"petezalew.ski/odmiany/internal/polimorf"
+)
+
+func main() {
+	inputPath := flag.String("input", "data/polish.txt.bz2", "path to polish.txt.bz2")
+	outDir := flag.String("out", "pkg/verb/testdata", "directory to write the corpus JSON files to")
+	keepArchaic := flag.Bool("archaic", false, "keep archaic present paradigms (szeptam, wykonywam)")
+	exclude := flag.String("exclude-qualifiers", "", "comma-separated SGJP qualifiers to drop from 5-column input, e.g. daw,przest,rzad,gwar")
+	flag.Parse()
+
+	f, err := os.Open(*inputPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "open: %v\n", err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	c, err := polimorf.Extract(bzip2.NewReader(f), polimorf.Options{
+		KeepArchaic:       *keepArchaic,
+		ExcludeQualifiers: splitList(*exclude),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "scan: %v\n", err)
+		os.Exit(1)
+	}
+
+	outputs := []struct {
+		file string
+		data any
+		desc string
+	}{
+		{"verbs.json", c.Present, fmt.Sprintf("%d present tense paradigms from %d infinitives", len(c.Present), c.PresentInfinitives)},
+		{"verbs_past.json", c.Past, fmt.Sprintf("%d past tense paradigms from %d infinitives", len(c.Past), c.PastInfinitives)},
+		{"verbs_verbal_noun.json", c.VerbalNouns, fmt.Sprintf("%d verbal noun entries from %d infinitives", len(c.VerbalNouns), c.VerbalNounInfinitives())},
+		{"verbs_imperative.json", c.Imperatives, fmt.Sprintf("%d imperative paradigms from %d infinitives", len(c.Imperatives), c.ImperativeInfinitives())},
+		{"verbs_participles.json", c.ParticipleCorpus(), fmt.Sprintf("%d adjectival and %d adverbial participles from %d infinitives", len(c.Participles), len(c.Adverbials), c.ParticipleInfinitives())},
+		{"verbs_gerund.json", c.Gerunds, fmt.Sprintf("%d gerund paradigms", len(c.Gerunds))},
+	}
+
+	for _, o := range outputs {
+		path := filepath.Join(*outDir, o.file)
+		if err := writeJSON(path, o.data); err != nil {
+			fmt.Fprintf(os.Stderr, "write %s: %v\n", path, err)
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stderr, "%s: %s\n", path, o.desc)
+	}
+}
+
+// writeJSON writes v as indented JSON, matching genverbs' stdout format.
+func writeJSON(path string, v any) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// splitList splits a comma-separated flag value, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
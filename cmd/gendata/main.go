@@ -0,0 +1,276 @@
+// Package main generates pkg/verb/data/frequency.tsv, the frequency lexicon
+// embedded in the verb package.
+//
+// Each line is "infinitive<TAB>aspect<TAB>frequency". The frequency is the
+// highest OpenSubtitles count (hermitdave/FrequencyWords) among the
+// infinitive and its past tense forms; the aspect comes from the past corpus
+// ("imperf", "perf", or "biasp" when Polimorf lists both). Verbs that never
+// occur in the frequency list are omitted.
+//
+// It also writes pkg/verb/data/paradigm_frequency.tsv, which ranks the
+// paradigms of present and past homographs. Each line is
+// "tense<TAB>infinitive<TAB>form<TAB>frequency", one per paradigm, where
+// the form is the paradigm's sg1 (present) or sg3m (past) and the
+// frequency is the summed count of all its forms less the most frequent
+// one, so that a single form colliding with a common word (stanie is also
+// the locative of stan) cannot decide the ranking. Homographs none of
+// whose forms occur are omitted.
+//
+// Given the present corpus, it also writes
+// pkg/verb/data/corpus/present.tsv.gz, the gold-standard paradigms behind
+// verb.Lookup: one gzipped "infinitive<TAB>sg1<TAB>...<TAB>pl3" line per
+// paradigm. Without the corpus that step is skipped and Lookup finds
+// nothing.
+//
+// Run from pkg/verb via go generate.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"petezalew.ski/odmiany/pkg/verb"
+)
+
+type pastEntry struct {
+	Infinitive string `json:"infinitive"`
+	Sg1M       string `json:"sg1m"`
+	Sg1F       string `json:"sg1f"`
+	Sg2M       string `json:"sg2m"`
+	Sg2F       string `json:"sg2f"`
+	Sg3M       string `json:"sg3m"`
+	Sg3F       string `json:"sg3f"`
+	Sg3N       string `json:"sg3n"`
+	Pl1V       string `json:"pl1v"`
+	Pl1NV      string `json:"pl1nv"`
+	Pl2V       string `json:"pl2v"`
+	Pl2NV      string `json:"pl2nv"`
+	Pl3V       string `json:"pl3v"`
+	Pl3NV      string `json:"pl3nv"`
+	Aspect     string `json:"aspect"`
+}
+
+func (e pastEntry) forms() []string {
+	return []string{
+		e.Infinitive,
+		e.Sg1M, e.Sg1F, e.Sg2M, e.Sg2F, e.Sg3M, e.Sg3F, e.Sg3N,
+		e.Pl1V, e.Pl1NV, e.Pl2V, e.Pl2NV, e.Pl3V, e.Pl3NV,
+	}
+}
+
+type lexiconEntry struct {
+	infinitive string
+	aspect     string
+	freq       int
+}
+
+func main() {
+	pastPath := flag.String("past", "testdata/verbs_past.json", "path to the past tense corpus")
+	freqPath := flag.String("freq", "testdata/pl_freq.txt", "path to the word frequency list")
+	outPath := flag.String("out", "data/frequency.tsv", "output path")
+	paradigmsPath := flag.String("paradigms-out", "data/paradigm_frequency.tsv", "output path for homograph paradigm frequencies")
+	presentPath := flag.String("present", "testdata/verbs.json", "path to the present tense corpus")
+	corpusPath := flag.String("corpus-out", "data/corpus/present.tsv.gz", "output path for the embedded present corpus")
+	flag.Parse()
+
+	freqMap, err := loadFrequency(*freqPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "frequency: %v\n", err)
+		os.Exit(1)
+	}
+
+	data, err := os.ReadFile(*pastPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "past corpus: %v\n", err)
+		os.Exit(1)
+	}
+	var entries []pastEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		fmt.Fprintf(os.Stderr, "past corpus: %v\n", err)
+		os.Exit(1)
+	}
+
+	lexicon := make(map[string]*lexiconEntry)
+	for _, e := range entries {
+		le, ok := lexicon[e.Infinitive]
+		if !ok {
+			le = &lexiconEntry{infinitive: e.Infinitive, aspect: e.Aspect}
+			lexicon[e.Infinitive] = le
+		} else if le.aspect != e.Aspect {
+			le.aspect = "biasp" // homograph paradigms of both aspects
+		}
+		for _, form := range e.forms() {
+			le.freq = max(le.freq, freqMap[form])
+		}
+	}
+
+	var out []*lexiconEntry
+	for _, le := range lexicon {
+		if le.freq > 0 {
+			out = append(out, le)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].infinitive < out[j].infinitive
+	})
+
+	var b strings.Builder
+	for _, le := range out {
+		fmt.Fprintf(&b, "%s\t%s\t%d\n", le.infinitive, le.aspect, le.freq)
+	}
+	if err := os.WriteFile(*outPath, []byte(b.String()), 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "write: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %d verbs to %s\n", len(out), *outPath)
+
+	infinitives := slices.Collect(maps.Keys(lexicon))
+	infinitives = append(infinitives, verb.IrregularPresentVerbs()...)
+	infinitives = append(infinitives, verb.IrregularPastVerbs()...)
+	slices.Sort(infinitives)
+	infinitives = slices.Compact(infinitives)
+
+	b.Reset()
+	homographs := 0
+	for _, infinitive := range infinitives {
+		if present, err := verb.ConjugatePresent(infinitive); err == nil {
+			ranked := make([]rankedParadigm, len(present))
+			for i, p := range present {
+				ranked[i] = rankedParadigm{p.Sg1, p.Forms(verb.AbbrevLabels)}
+			}
+			homographs += writeRanking(&b, freqMap, verb.Present, infinitive, ranked)
+		}
+		if past, err := verb.ConjugatePast(infinitive); err == nil {
+			ranked := make([]rankedParadigm, len(past))
+			for i, p := range past {
+				ranked[i] = rankedParadigm{p.Sg3M, p.Forms(verb.AbbrevLabels)}
+			}
+			homographs += writeRanking(&b, freqMap, verb.Past, infinitive, ranked)
+		}
+	}
+	if err := os.WriteFile(*paradigmsPath, []byte(b.String()), 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "write: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %d homographs to %s\n", homographs, *paradigmsPath)
+
+	n, err := writePresentCorpus(*presentPath, *corpusPath)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		fmt.Fprintf(os.Stderr, "No present corpus at %s, skipping %s\n", *presentPath, *corpusPath)
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "present corpus: %v\n", err)
+		os.Exit(1)
+	default:
+		fmt.Fprintf(os.Stderr, "Wrote %d present paradigms to %s\n", n, *corpusPath)
+	}
+}
+
+// presentEntry is one paradigm of the present tense corpus.
+type presentEntry struct {
+	Infinitive string `json:"infinitive"`
+	Sg1        string `json:"sg1"`
+	Sg2        string `json:"sg2"`
+	Sg3        string `json:"sg3"`
+	Pl1        string `json:"pl1"`
+	Pl2        string `json:"pl2"`
+	Pl3        string `json:"pl3"`
+}
+
+// writePresentCorpus converts the present corpus JSON to the gzipped TSV
+// embedded in the verb package, in corpus order, and returns the number
+// of paradigms written.
+func writePresentCorpus(inPath, outPath string) (int, error) {
+	data, err := os.ReadFile(inPath)
+	if err != nil {
+		return 0, err
+	}
+	var entries []presentEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return 0, err
+	}
+
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range entries {
+		fmt.Fprintf(zw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			e.Infinitive, e.Sg1, e.Sg2, e.Sg3, e.Pl1, e.Pl2, e.Pl3)
+	}
+	if err := zw.Close(); err != nil {
+		return 0, err
+	}
+	return len(entries), os.WriteFile(outPath, buf.Bytes(), 0o644)
+}
+
+// rankedParadigm is one paradigm of a homograph: the form that identifies
+// it and all its forms.
+type rankedParadigm struct {
+	key   string
+	forms []verb.Form
+}
+
+// writeRanking writes the ranking lines of a homograph and returns 1, or
+// returns 0 without writing for a single paradigm or one with no counts.
+func writeRanking(b *strings.Builder, freqMap map[string]int, tense verb.Tense, infinitive string, paradigms []rankedParadigm) int {
+	if len(paradigms) < 2 {
+		return 0
+	}
+	freqs := make([]int, len(paradigms))
+	for i, p := range paradigms {
+		freqs[i] = paradigmFrequency(freqMap, p.forms)
+	}
+	if slices.Max(freqs) == 0 {
+		return 0
+	}
+	for i, p := range paradigms {
+		fmt.Fprintf(b, "%s\t%s\t%s\t%d\n", tense, infinitive, p.key, freqs[i])
+	}
+	return 1
+}
+
+// paradigmFrequency returns the summed word count of a paradigm's forms,
+// leaving out the most frequent one.
+func paradigmFrequency(freqMap map[string]int, forms []verb.Form) int {
+	total, top := 0, 0
+	for _, f := range forms {
+		c := freqMap[f.Value]
+		total += c
+		top = max(top, c)
+	}
+	return total - top
+}
+
+// loadFrequency loads word frequency data from hermitdave format: "word count"
+func loadFrequency(path string) (map[string]int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	freq := make(map[string]int)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		parts := strings.Fields(scanner.Text())
+		if len(parts) >= 2 {
+			count, _ := strconv.Atoi(parts[1])
+			freq[parts[0]] = count
+		}
+	}
+	return freq, scanner.Err()
+}
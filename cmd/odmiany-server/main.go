@@ -0,0 +1,147 @@
+// Command odmiany-server serves the conjugation API over HTTP:
+//
+//	GET /conjugate?verb=czytać&tense=present
+//	GET /conjugate?verb=czytać&all=true
+//
+// tense is present (the default), past, future or gerund (the verbal
+// noun); all=true returns every section ConjugateAll can build. Responses
+// are JSON. Input that is not an infinitive, or longer than maxVerbRunes,
+// gets 400 and a verb no heuristic matches 404, both with an error body.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"petezalew.ski/odmiany/pkg/verb"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	flag.Parse()
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           newMux(),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+	}
+	log.Printf("listening on %s", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
+
+// maxVerbRunes bounds the verb parameter. The longest Polish infinitives
+// are well under it; longer input is rejected before conjugating.
+const maxVerbRunes = 64
+
+// newMux returns the server's routes.
+func newMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /conjugate", handleConjugate)
+	return mux
+}
+
+// conjugation is the JSON body for a single tense. Only the requested
+// tense is set.
+type conjugation struct {
+	Infinitive string                `json:"infinitive"`
+	Present    []verb.Paradigm       `json:"present,omitempty"`
+	Past       []verb.PastParadigm   `json:"past,omitempty"`
+	Future     []verb.FutureParadigm `json:"future,omitempty"`
+	VerbalNoun []string              `json:"verbalNoun,omitempty"`
+}
+
+// fullConjugation is the JSON body for all=true, with the errors of the
+// sections that could not be built as strings.
+type fullConjugation struct {
+	*verb.FullParadigm
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// errorBody is the JSON body of every error response.
+type errorBody struct {
+	Error string `json:"error"`
+}
+
+func handleConjugate(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	infinitive := q.Get("verb")
+	if infinitive == "" {
+		writeError(w, http.StatusBadRequest, errors.New("missing verb parameter"))
+		return
+	}
+	if utf8.RuneCountInString(infinitive) > maxVerbRunes {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("verb parameter longer than %d characters", maxVerbRunes))
+		return
+	}
+
+	if q.Get("all") == "true" {
+		p, err := verb.ConjugateAll(infinitive)
+		if err != nil {
+			writeError(w, errorStatus(err), err)
+			return
+		}
+		full := fullConjugation{FullParadigm: p}
+		if len(p.Errors) > 0 {
+			full.Errors = make(map[string]string, len(p.Errors))
+			for section, err := range p.Errors {
+				full.Errors[section] = err.Error()
+			}
+		}
+		writeJSON(w, http.StatusOK, full)
+		return
+	}
+
+	c := conjugation{Infinitive: infinitive}
+	var err error
+	switch tense := q.Get("tense"); tense {
+	case "", "present":
+		c.Present, err = verb.ConjugatePresent(infinitive)
+	case "past":
+		c.Past, err = verb.ConjugatePast(infinitive)
+	case "future":
+		c.Future, err = verb.ConjugateFuture(infinitive)
+	case "gerund":
+		c.VerbalNoun, err = verb.VerbalNoun(infinitive)
+	default:
+		writeError(w, http.StatusBadRequest, errors.New("unknown tense "+tense+": want present, past, future or gerund"))
+		return
+	}
+	if err != nil {
+		writeError(w, errorStatus(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+// errorStatus maps a conjugation error to a status code: 400 for input
+// that is not an infinitive, 404 when no heuristic matched the verb, 500
+// otherwise.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, verb.ErrNotInfinitive):
+		return http.StatusBadRequest
+	case errors.Is(err, verb.ErrNoMatch):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorBody{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("writing response: %v", err)
+	}
+}
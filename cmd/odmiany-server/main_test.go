@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// get sends a GET request for path to the server's mux.
+func get(t *testing.T, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestConjugatePresent(t *testing.T) {
+	rec := get(t, "/conjugate?verb="+url.QueryEscape("czytać")+"&tense=present")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200; body %s", rec.Code, rec.Body)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+
+	var got struct {
+		Infinitive string `json:"infinitive"`
+		Present    []struct {
+			Forms struct {
+				Sg1 string `json:"sg1"`
+				Pl3 string `json:"pl3"`
+			} `json:"forms"`
+		} `json:"present"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Infinitive != "czytać" || len(got.Present) != 1 ||
+		got.Present[0].Forms.Sg1 != "czytam" || got.Present[0].Forms.Pl3 != "czytają" {
+		t.Errorf("got %+v, want czytać → czytam ... czytają", got)
+	}
+}
+
+func TestConjugateAll(t *testing.T) {
+	rec := get(t, "/conjugate?verb="+url.QueryEscape("czytać")+"&all=true")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200; body %s", rec.Code, rec.Body)
+	}
+	var got struct {
+		VerbalNoun []string `json:"verbalNoun"`
+		Errors     map[string]string
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.VerbalNoun) != 1 || got.VerbalNoun[0] != "czytanie" {
+		t.Errorf("verbalNoun = %q, want [czytanie]", got.VerbalNoun)
+	}
+	// czytać is imperfective: no anterior adverbial participle
+	if got.Errors["anteriorAdverbial"] == "" {
+		t.Errorf("errors = %v, want an anteriorAdverbial error", got.Errors)
+	}
+}
+
+func TestConjugateErrors(t *testing.T) {
+	tests := []struct {
+		path string
+		want int
+	}{
+		{"/conjugate", http.StatusBadRequest},
+		{"/conjugate?verb=czytać&tense=pluperfect", http.StatusBadRequest},
+		{"/conjugate?verb=xyz", http.StatusBadRequest},
+		{"/conjugate?verb=" + strings.Repeat("podo", 20) + "ybywać", http.StatusBadRequest},
+		{"/conjugate?verb=x%C3%B3%C4%87", http.StatusNotFound}, // xóć
+		{"/conjugate?verb=x%C3%B3%C4%87&all=true", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := get(t, tt.path)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.want, rec.Body)
+			}
+			var body errorBody
+			if err := json.NewDecoder(rec.Body).Decode(&body); err != nil || body.Error == "" {
+				t.Errorf("body = %+v (%v), want an error message", body, err)
+			}
+		})
+	}
+}
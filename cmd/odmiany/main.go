@@ -1,125 +1,566 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"petezalew.ski/odmiany/pkg/verb"
 )
 
 func main() {
-	past := flag.Bool("past", false, "show past tense conjugation")
-	vn := flag.Bool("vn", false, "show verbal noun (rzeczownik odsłownikowy)")
-	flag.Parse()
-
-	verbs := flag.Args()
-	if len(verbs) < 1 {
-		fmt.Fprintln(os.Stderr, "usage: odmiany [-past|-vn] <verb> [verb2] [verb3] ...")
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+}
+
+// command is an odmiany subcommand: how it prints a verb as text and as
+// JSON.
+type command struct {
+	name  string
+	usage string
+	show  func(p *printer, infinitive string, compact bool)
+	entry func(p *printer, infinitive string) any
+}
+
+// commands lists the subcommands, the first being the default.
+var commands = []command{
+	{"present", "present tense (the default)", (*printer).showPresentTense, (*printer).presentEntry},
+	{"past", "past tense", (*printer).showPastTense, (*printer).pastEntry},
+	{"future", "future tense", (*printer).showFutureTense, (*printer).futureEntry},
+	{"conditional", "conditional mood", (*printer).showConditional, (*printer).conditionalEntry},
+	{"imperative", "imperative mood", (*printer).showImperative, (*printer).imperativeEntry},
+	{"vn", "verbal noun (rzeczownik odsłownikowy)", (*printer).showVerbalNoun, (*printer).verbalNounEntry},
+	{"all", "every tense and mood, with the verbal noun", (*printer).showAll, (*printer).allEntry},
+}
+
+// lookupCommand returns the subcommand called name.
+func lookupCommand(name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+// run parses the command line, reads verbs from stdin with -stdin and
+// prints to stdout and stderr. Flags may come before or after the
+// subcommand: odmiany -json past czytać, odmiany past -json czytać. The
+// -past, -vn and -all flags predate the subcommands; they still select
+// one when none is given, with a note on stderr.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("odmiany", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	past := fs.Bool("past", false, "deprecated: use odmiany past")
+	vn := fs.Bool("vn", false, "deprecated: use odmiany vn")
+	all := fs.Bool("all", false, "deprecated: use odmiany all")
+	labelsFlag := fs.String("labels", "pl", "form labels: pl (ja/ty), en (I/you) or abbr (1sg/2sg)")
+	jsonOut := fs.Bool("json", false, "print the paradigms as JSON")
+	stress := fs.Bool("stress", false, "mark the stressed vowel of each printed form")
+	ascii := fs.Bool("ascii", false, "print forms without Polish diacritics (ą → a, ż → z)")
+	useStdin := fs.Bool("stdin", false, "read verbs from standard input, one per line")
+	formatFlag := fs.String("format", "text", "table format for a single verb: text, markdown or csv")
+	futureFlag := fs.String("future", "both", "analytic future style: both, infinitive (będę czytać) or participle (będę czytał)")
+	fs.Usage = func() {
+		out := fs.Output()
+		fmt.Fprintln(out, "usage: odmiany [command] [flags] <verb> [verb2] [verb3] ...")
+		fmt.Fprintln(out, "       odmiany [command] [flags] -stdin (or -) < verbs.txt")
+		fmt.Fprintln(out, "\ncommands:")
+		for _, c := range commands {
+			fmt.Fprintf(out, "  %-11s %s\n", c.name, c.usage)
+		}
+		fmt.Fprintln(out, "\nflags:")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	cmd, ok := lookupCommand(fs.Arg(0))
+	if ok {
+		if err := fs.Parse(fs.Args()[1:]); err != nil {
+			return err
+		}
+	}
+	for _, d := range []struct {
+		set  bool
+		name string
+	}{{*all, "all"}, {*vn, "vn"}, {*past, "past"}} {
+		if !d.set {
+			continue
+		}
+		fmt.Fprintf(stderr, "odmiany: -%s is deprecated and will be removed; use odmiany %s\n", d.name, d.name)
+		if !ok {
+			cmd, ok = lookupCommand(d.name)
+		}
+	}
+	if !ok {
+		cmd = commands[0]
+	}
+
+	p := &printer{w: stdout, stderr: stderr, accent: func(form string) string { return form }}
+	var err error
+	if p.labels, err = verb.ParseLabels(*labelsFlag); err != nil {
+		return err
+	}
+	if p.format, err = verb.ParseFormat(*formatFlag); err != nil {
+		return err
+	}
+	if p.future, err = verb.ParseFutureStyle(*futureFlag); err != nil {
+		return err
+	}
+	if *stress {
+		p.accent = verb.AccentForm
+	}
+	if *ascii {
+		stressed := p.accent
+		p.accent = func(form string) string { return verb.ASCIIize(stressed(form)) }
+	}
+
+	verbs := fs.Args()
+	if len(verbs) == 1 && verbs[0] == "-" {
+		*useStdin, verbs = true, nil
+	}
+	if len(verbs) < 1 && !*useStdin {
+		fs.Usage()
+		return errors.New("odmiany: no verb given")
+	}
+
+	if *useStdin {
+		// Stream: compact lines, or one JSON object per line.
+		enc := json.NewEncoder(stdout)
+		return scanVerbs(stdin, func(infinitive string) error {
+			if *jsonOut {
+				return enc.Encode(cmd.entry(p, infinitive))
+			}
+			cmd.show(p, infinitive, true)
+			return nil
+		})
+	}
+
+	if *jsonOut {
+		return writeJSON(stdout, verbs, func(infinitive string) any {
+			return cmd.entry(p, infinitive)
+		})
+	}
 
 	compact := len(verbs) > 1
 
 	for i, infinitive := range verbs {
-		switch {
-		case *vn:
-			showVerbalNoun(infinitive)
-		case *past:
-			showPastTense(infinitive, compact)
-		default:
-			showPresentTense(infinitive, compact)
-		}
+		cmd.show(p, infinitive, compact)
 
 		if !compact && i < len(verbs)-1 {
-			fmt.Println()
+			fmt.Fprintln(stdout)
+		}
+	}
+	return nil
+}
+
+// scanVerbs calls fn with each infinitive read from r, one per line,
+// skipping blank lines and # comments. Lines are read one at a time, so
+// the whole corpus can be piped through without holding it in memory.
+func scanVerbs(r io.Reader, fn func(infinitive string) error) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return err
 		}
 	}
+	return scanner.Err()
+}
+
+// printer holds the output streams and display settings of one run.
+// A verb that cannot be conjugated is reported on stderr, and the run
+// goes on with the next one.
+type printer struct {
+	w, stderr io.Writer
+	labels    verb.Labels
+
+	// format is the -format table format. Text tables are printed here,
+	// with the chosen labels and accents; Markdown and CSV go through
+	// verb.Paradigm.WriteFormat.
+	format verb.Format
+
+	// future is the -future style of the analytic future.
+	future verb.FutureStyle
+
+	// accent is applied to every form printed as text; -stress sets it
+	// to verb.AccentForm, and -ascii passes its result through
+	// verb.ASCIIize.
+	accent func(form string) string
+}
+
+// fail reports that a verb could not be conjugated.
+func (p *printer) fail(infinitive string, err error) {
+	fmt.Fprintf(p.stderr, "%s: %v\n", infinitive, err)
+}
+
+// accentAll applies accent to each form.
+func (p *printer) accentAll(forms []string) []string {
+	out := make([]string, len(forms))
+	for i, f := range forms {
+		out[i] = p.accent(f)
+	}
+	return out
 }
 
-func showVerbalNoun(infinitive string) {
+func (p *printer) showVerbalNoun(infinitive string, _ bool) {
 	forms, err := verb.VerbalNoun(infinitive)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "%s: %v\n", infinitive, err)
+		p.fail(infinitive, err)
 		return
 	}
-	fmt.Printf("%s: %s\n", infinitive, strings.Join(forms, ", "))
+	p.printVerbalNoun(infinitive, forms)
 }
 
-func showPresentTense(infinitive string, compact bool) {
+func (p *printer) showPresentTense(infinitive string, compact bool) {
 	paradigms, err := verb.ConjugatePresent(infinitive)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "%s: %v\n", infinitive, err)
+		p.fail(infinitive, err)
 		return
 	}
+	p.printPresent("Present tense", infinitive, paradigms, compact)
+}
 
-	if compact {
-		// Compact format for multiple verbs
-		for _, p := range paradigms {
-			fmt.Printf("%s: %s, %s, %s, %s, %s, %s\n",
-				infinitive, p.Sg1, p.Sg2, p.Sg3, p.Pl1, p.Pl2, p.Pl3)
+func (p *printer) showPastTense(infinitive string, compact bool) {
+	paradigms, err := verb.ConjugatePast(infinitive)
+	if err != nil {
+		p.fail(infinitive, err)
+		return
+	}
+	p.printPast("Past tense", infinitive, paradigms, compact)
+}
+
+func (p *printer) showFutureTense(infinitive string, compact bool) {
+	paradigms, err := verb.ConjugateFutureStyle(infinitive, p.future)
+	if err != nil {
+		p.fail(infinitive, err)
+		return
+	}
+	p.printFuture("Future tense", infinitive, paradigms, compact)
+}
+
+func (p *printer) showConditional(infinitive string, compact bool) {
+	paradigms, err := verb.ConjugateConditional(infinitive)
+	if err != nil {
+		p.fail(infinitive, err)
+		return
+	}
+	p.printConditional("Conditional", infinitive, paradigms, compact)
+}
+
+func (p *printer) showImperative(infinitive string, compact bool) {
+	paradigms, err := verb.Imperative(infinitive)
+	if err != nil {
+		p.fail(infinitive, err)
+		return
+	}
+	p.printImperative(infinitive, paradigms, compact)
+}
+
+// printFuture prints future paradigms as present tense shaped ones, then
+// the l-participle variants of the analytic ones like printPast, as far as
+// the -future style keeps them.
+func (p *printer) printFuture(title, name string, paradigms []verb.FutureParadigm, compact bool) {
+	var future []verb.Paradigm
+	var participle []verb.PastParadigm
+	for _, f := range paradigms {
+		f = f.WithStyle(p.future)
+		if f.Sg1 != "" {
+			future = append(future, verb.Paradigm{PresentTense: f.PresentTense, Gloss: f.Gloss})
 		}
-	} else {
-		// Detailed format for single verb
-		fmt.Printf("Present tense of %s:\n", infinitive)
-		for j, p := range paradigms {
-			if len(paradigms) > 1 {
-				if p.Gloss != "" {
-					fmt.Printf("\n  [%d] %s:\n", j+1, p.Gloss)
-				} else {
-					fmt.Printf("\n  [%d]:\n", j+1)
-				}
-			}
-			fmt.Printf("  ja      %s\n", p.Sg1)
-			fmt.Printf("  ty      %s\n", p.Sg2)
-			fmt.Printf("  on/ona  %s\n", p.Sg3)
-			fmt.Printf("  my      %s\n", p.Pl1)
-			fmt.Printf("  wy      %s\n", p.Pl2)
-			fmt.Printf("  oni/one %s\n", p.Pl3)
+		if f.Participle != nil {
+			participle = append(participle, verb.PastParadigm{PastTense: *f.Participle, Gloss: f.Gloss})
 		}
 	}
+	if len(future) > 0 {
+		p.printPresent(title, name, future, compact)
+	}
+	if len(participle) > 0 {
+		if len(future) > 0 && !compact {
+			fmt.Fprintln(p.w)
+		}
+		p.printPast(title+" (with participle)", name, participle, compact)
+	}
 }
 
-func showPastTense(infinitive string, compact bool) {
-	paradigms, err := verb.ConjugatePast(infinitive)
+// printConditional prints conditional paradigms like printPast.
+func (p *printer) printConditional(title, name string, paradigms []verb.ConditionalParadigm, compact bool) {
+	past := make([]verb.PastParadigm, len(paradigms))
+	for i, c := range paradigms {
+		past[i] = verb.PastParadigm{PastTense: c.PastTense, Gloss: c.Gloss}
+	}
+	p.printPast(title, name, past, compact)
+}
+
+// showAll prints every section of verb.ConjugateAll: as one block of
+// tables for a single verb, or as compact lines prefixed with the section
+// name for several. A section that cannot be built prints a one-line note
+// instead.
+func (p *printer) showAll(infinitive string, compact bool) {
+	full, err := verb.ConjugateAll(infinitive)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "%s: %v\n", infinitive, err)
+		p.fail(infinitive, err)
+		return
+	}
+
+	sections := []struct {
+		key, title string
+		print      func(title, name string)
+	}{
+		{"present", "Present tense", func(title, name string) {
+			p.printPresent(title, name, full.Present, compact)
+		}},
+		{"past", "Past tense", func(title, name string) {
+			p.printPast(title, name, full.Past, compact)
+		}},
+		{"verbalNoun", "Verbal noun", func(title, name string) {
+			if compact {
+				p.printVerbalNoun(name, full.VerbalNoun)
+			} else {
+				p.printVerbalNoun(title+" of "+name, full.VerbalNoun)
+			}
+		}},
+		{"future", "Future tense", func(title, name string) {
+			p.printFuture(title, name, full.Future, compact)
+		}},
+		{"conditional", "Conditional", func(title, name string) {
+			p.printConditional(title, name, full.Conditional, compact)
+		}},
+		{"imperative", "Imperative", func(_, name string) {
+			p.printImperative(name, full.Imperative, compact)
+		}},
+	}
+
+	for i, s := range sections {
+		name := infinitive
+		if compact {
+			name = strings.ToLower(strings.TrimSuffix(s.title, " tense")) + " " + infinitive
+		}
+		if i > 0 && !compact {
+			fmt.Fprintln(p.w)
+		}
+		if err := full.Errors[s.key]; err != nil {
+			if compact {
+				fmt.Fprintf(p.w, "%s: (%v)\n", name, err)
+			} else {
+				fmt.Fprintf(p.w, "%s of %s: (%v)\n", s.title, name, err)
+			}
+			continue
+		}
+		s.print(s.title, name)
+	}
+}
+
+// printVerbalNoun prints the verbal nouns of a verb on one line after name.
+func (p *printer) printVerbalNoun(name string, forms []string) {
+	fmt.Fprintf(p.w, "%s: %s\n", name, strings.Join(p.accentAll(forms), ", "))
+}
+
+// printHeading prints the "[n] gloss:" line above the j-th of several
+// paradigms of a verb.
+func (p *printer) printHeading(j, n int, gloss string) {
+	if n < 2 {
 		return
 	}
+	if gloss != "" {
+		fmt.Fprintf(p.w, "\n  [%d] %s:\n", j+1, gloss)
+	} else {
+		fmt.Fprintf(p.w, "\n  [%d]:\n", j+1)
+	}
+}
 
+// printPresent prints present tense shaped paradigms: one line each after
+// name when compact, otherwise a table each under a "title of name"
+// heading.
+func (p *printer) printPresent(title, name string, paradigms []verb.Paradigm, compact bool) {
 	if compact {
 		// Compact format for multiple verbs
-		for _, p := range paradigms {
-			fmt.Printf("%s: %s/%s, %s/%s, %s/%s/%s, %s/%s, %s/%s, %s/%s\n",
-				infinitive,
-				p.Sg1M, p.Sg1F, p.Sg2M, p.Sg2F, p.Sg3M, p.Sg3F, p.Sg3N,
-				p.Pl1V, p.Pl1NV, p.Pl2V, p.Pl2NV, p.Pl3V, p.Pl3NV)
+		for _, par := range paradigms {
+			fmt.Fprintf(p.w, "%s: %s\n", name, strings.Join(p.accentAll([]string{
+				par.Sg1, par.Sg2, par.Sg3, par.Pl1, par.Pl2, par.Pl3,
+			}), ", "))
 		}
-	} else {
-		// Detailed format for single verb
-		fmt.Printf("Past tense of %s:\n", infinitive)
-		for j, p := range paradigms {
-			if len(paradigms) > 1 {
-				if p.Gloss != "" {
-					fmt.Printf("\n  [%d] %s:\n", j+1, p.Gloss)
-				} else {
-					fmt.Printf("\n  [%d]:\n", j+1)
-				}
-			}
-			fmt.Printf("  ja (m)      %s\n", p.Sg1M)
-			fmt.Printf("  ja (f)      %s\n", p.Sg1F)
-			fmt.Printf("  ty (m)      %s\n", p.Sg2M)
-			fmt.Printf("  ty (f)      %s\n", p.Sg2F)
-			fmt.Printf("  on          %s\n", p.Sg3M)
-			fmt.Printf("  ona         %s\n", p.Sg3F)
-			fmt.Printf("  ono         %s\n", p.Sg3N)
-			fmt.Printf("  my (v)      %s\n", p.Pl1V)
-			fmt.Printf("  my (nv)     %s\n", p.Pl1NV)
-			fmt.Printf("  wy (v)      %s\n", p.Pl2V)
-			fmt.Printf("  wy (nv)     %s\n", p.Pl2NV)
-			fmt.Printf("  oni         %s\n", p.Pl3V)
-			fmt.Printf("  one         %s\n", p.Pl3NV)
+		return
+	}
+
+	// Detailed format for single verb
+	fmt.Fprintf(p.w, "%s of %s:\n", title, name)
+	for j, par := range paradigms {
+		p.printHeading(j, len(paradigms), par.Gloss)
+		if p.format != verb.FormatText {
+			par.WriteFormat(p.w, p.format)
+			continue
+		}
+		p.printTable(par.PresentTense.Table(p.labels))
+	}
+}
+
+// printPast prints past tense shaped paradigms like printPresent.
+func (p *printer) printPast(title, name string, paradigms []verb.PastParadigm, compact bool) {
+	if compact {
+		// Compact format for multiple verbs
+		for _, par := range paradigms {
+			f := p.accentAll([]string{
+				par.Sg1M, par.Sg1F, par.Sg2M, par.Sg2F, par.Sg3M, par.Sg3F, par.Sg3N,
+				par.Pl1V, par.Pl1NV, par.Pl2V, par.Pl2NV, par.Pl3V, par.Pl3NV,
+			})
+			fmt.Fprintf(p.w, "%s: %s/%s, %s/%s, %s/%s/%s, %s/%s, %s/%s, %s/%s\n",
+				name, f[0], f[1], f[2], f[3], f[4], f[5], f[6],
+				f[7], f[8], f[9], f[10], f[11], f[12])
+		}
+		return
+	}
+
+	// Detailed format for single verb
+	fmt.Fprintf(p.w, "%s of %s:\n", title, name)
+	for j, par := range paradigms {
+		p.printHeading(j, len(paradigms), par.Gloss)
+		if p.format != verb.FormatText {
+			par.WriteFormat(p.w, p.format)
+			continue
 		}
+		p.printTable(par.PastTense.Table(p.labels))
+	}
+}
+
+// printImperative prints imperative paradigms like printPresent, under
+// the present tense labels of their persons (ty, my, wy).
+func (p *printer) printImperative(name string, paradigms []verb.ImperativeParadigm, compact bool) {
+	if compact {
+		for _, par := range paradigms {
+			fmt.Fprintf(p.w, "%s: %s\n", name, strings.Join(p.accentAll([]string{par.Sg2, par.Pl1, par.Pl2}), ", "))
+		}
+		return
+	}
+
+	fmt.Fprintf(p.w, "Imperative of %s:\n", name)
+	for j, par := range paradigms {
+		p.printHeading(j, len(paradigms), par.Gloss)
+		table := par.Table(p.labels)
+		table.Rows = slices.DeleteFunc(table.Rows, func(row verb.TableRow) bool {
+			return row.Forms[0] == ""
+		})
+		p.printTable(table)
+	}
+}
+
+// printTable prints a paradigm table with its labels in an aligned column.
+func (p *printer) printTable(table verb.Table) {
+	width := 0
+	for _, row := range table.Rows {
+		width = max(width, utf8.RuneCountInString(row.Label))
+	}
+	for _, row := range table.Rows {
+		pad := width - utf8.RuneCountInString(row.Label) + 1
+		fmt.Fprintf(p.w, "  %s%s%s\n", row.Label, strings.Repeat(" ", pad), strings.Join(p.accentAll(row.Forms), ", "))
+	}
+}
+
+// jsonEntry is the JSON form of one verb's paradigms. Only the selected
+// tense is set; Error replaces it when the verb cannot be conjugated.
+type jsonEntry struct {
+	Infinitive  string                     `json:"infinitive"`
+	Present     []verb.Paradigm            `json:"present,omitempty"`
+	Past        []verb.PastParadigm        `json:"past,omitempty"`
+	Future      []verb.FutureParadigm      `json:"future,omitempty"`
+	Conditional []verb.ConditionalParadigm `json:"conditional,omitempty"`
+	Imperative  []verb.ImperativeParadigm  `json:"imperative,omitempty"`
+	VerbalNoun  []string                   `json:"verbalNoun,omitempty"`
+	Error       string                     `json:"error,omitempty"`
+}
+
+// withError sets e.Error to err's message, if any.
+func (e jsonEntry) withError(err error) jsonEntry {
+	if err != nil {
+		e.Error = err.Error()
+	}
+	return e
+}
+
+func (p *printer) presentEntry(infinitive string) any {
+	e := jsonEntry{Infinitive: infinitive}
+	var err error
+	e.Present, err = verb.ConjugatePresent(infinitive)
+	return e.withError(err)
+}
+
+func (p *printer) pastEntry(infinitive string) any {
+	e := jsonEntry{Infinitive: infinitive}
+	var err error
+	e.Past, err = verb.ConjugatePast(infinitive)
+	return e.withError(err)
+}
+
+func (p *printer) futureEntry(infinitive string) any {
+	e := jsonEntry{Infinitive: infinitive}
+	var err error
+	e.Future, err = verb.ConjugateFutureStyle(infinitive, p.future)
+	return e.withError(err)
+}
+
+func (p *printer) conditionalEntry(infinitive string) any {
+	e := jsonEntry{Infinitive: infinitive}
+	var err error
+	e.Conditional, err = verb.ConjugateConditional(infinitive)
+	return e.withError(err)
+}
+
+func (p *printer) imperativeEntry(infinitive string) any {
+	e := jsonEntry{Infinitive: infinitive}
+	var err error
+	e.Imperative, err = verb.Imperative(infinitive)
+	return e.withError(err)
+}
+
+func (p *printer) verbalNounEntry(infinitive string) any {
+	e := jsonEntry{Infinitive: infinitive}
+	var err error
+	e.VerbalNoun, err = verb.VerbalNoun(infinitive)
+	return e.withError(err)
+}
+
+// allEntry is verb.ConjugateAll's paradigm, or a jsonEntry with the error
+// when no section could be built.
+func (p *printer) allEntry(infinitive string) any {
+	full, err := verb.ConjugateAll(infinitive)
+	if err != nil {
+		return jsonEntry{Infinitive: infinitive}.withError(err)
+	}
+	for i, f := range full.Future {
+		full.Future[i] = f.WithStyle(p.future)
+	}
+	return full
+}
+
+// writeJSON prints entry for each verb as indented JSON: an object for a
+// single verb, an array for several.
+func writeJSON(w io.Writer, verbs []string, entry func(infinitive string) any) error {
+	entries := make([]any, len(verbs))
+	for i, infinitive := range verbs {
+		entries[i] = entry(infinitive)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if len(entries) == 1 {
+		return enc.Encode(entries[0])
 	}
+	return enc.Encode(entries)
 }
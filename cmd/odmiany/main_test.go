@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+// runOut runs odmiany with args and returns what it printed on stdout.
+func runOut(t *testing.T, args ...string) string {
+	t.Helper()
+	var out strings.Builder
+	if err := run(args, strings.NewReader(""), &out, io.Discard); err != nil {
+		t.Fatalf("run(%q) error: %v", args, err)
+	}
+	return out.String()
+}
+
+func TestRunSubcommands(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"czytać", "pisać"}, "czytać: czytam, czytasz, czyta, czytamy, czytacie, czytają\n"},
+		{[]string{"present", "czytać", "pisać"}, "pisać: piszę, piszesz, pisze, piszemy, piszecie, piszą\n"},
+		{[]string{"past", "czytać", "pisać"}, "czytać: czytałem/czytałam, czytałeś/czytałaś, czytał/czytała/czytało, czytaliśmy/czytałyśmy, czytaliście/czytałyście, czytali/czytały\n"},
+		{[]string{"future", "czytać", "napisać"}, "napisać: napiszę, napiszesz, napisze, napiszemy, napiszecie, napiszą\n"},
+		{[]string{"conditional", "czytać", "móc"}, "móc: mógłbym/mogłabym"},
+		{[]string{"imperative", "czytać", "pisać"}, "pisać: pisz, piszmy, piszcie\n"},
+		{[]string{"future", "-future=participle", "czytać", "pisać"}, "pisać: będę pisał/będę pisała"},
+		{[]string{"vn", "czytać", "pić"}, "pić: picie\n"},
+		{[]string{"all", "czytać", "pisać"}, "verbal noun pisać: pisanie\n"},
+		// flags after the subcommand, and the deprecated flags
+		{[]string{"past", "-ascii", "czytać", "pić"}, "pić: pilem/pilam"},
+		{[]string{"-past", "czytać", "pisać"}, "pisać: pisałem/pisałam"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			if got := runOut(t, tt.args...); !strings.Contains(got, tt.want) {
+				t.Errorf("run(%q) =\n%s\nwant it to contain %q", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunTable(t *testing.T) {
+	got := runOut(t, "past", "-labels=abbr", "czytać")
+	if !strings.HasPrefix(got, "Past tense of czytać:\n  1sg.m  czytałem\n") {
+		t.Errorf("run(past czytać) =\n%s", got)
+	}
+}
+
+func TestRunJSON(t *testing.T) {
+	var got struct {
+		Infinitive string `json:"infinitive"`
+		Future     []struct {
+			Sg1 string `json:"sg1"`
+		} `json:"future"`
+	}
+	if err := json.Unmarshal([]byte(runOut(t, "-json", "future", "napisać")), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Infinitive != "napisać" || len(got.Future) != 1 || got.Future[0].Sg1 != "napiszę" {
+		t.Errorf("run(-json future napisać) = %+v, want napiszę", got)
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{},
+		{"past"},
+		{"-format=xml", "czytać"},
+		{"future", "-future=gerund", "czytać"},
+		{"-nosuchflag", "czytać"},
+	} {
+		var out strings.Builder
+		if err := run(args, strings.NewReader(""), &out, io.Discard); err == nil {
+			t.Errorf("run(%q) = nil error, want an error", args)
+		}
+	}
+}
+
+func TestRunFutureStyle(t *testing.T) {
+	got := runOut(t, "future", "-future=infinitive", "czytać", "pisać")
+	if strings.Contains(got, "czytał") || !strings.Contains(got, "czytać: będę czytać,") {
+		t.Errorf("run(future -future=infinitive) =\n%s\nwant only będę czytać", got)
+	}
+	got = runOut(t, "future", "-future=participle", "czytać", "pisać")
+	if strings.Contains(got, "będę czytać") || !strings.Contains(got, "czytać: będę czytał/będę czytała") {
+		t.Errorf("run(future -future=participle) =\n%s\nwant only będę czytał", got)
+	}
+}
+
+func TestRunStdin(t *testing.T) {
+	var out, errOut strings.Builder
+	stdin := strings.NewReader("# verbs\nczytać\n\nxyz\n")
+	if err := run([]string{"-past", "-"}, stdin, &out, &errOut); err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if got := out.String(); !strings.HasPrefix(got, "czytać: czytałem/czytałam") || strings.Count(got, "\n") != 1 {
+		t.Errorf("stdout =\n%s\nwant the one czytać line", got)
+	}
+	// the deprecation note and the failed verb go to stderr
+	if got := errOut.String(); !strings.Contains(got, "-past is deprecated") || !strings.Contains(got, "xyz: ") {
+		t.Errorf("stderr =\n%s\nwant the deprecation note and the xyz error", got)
+	}
+}
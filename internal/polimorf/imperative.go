@@ -0,0 +1,94 @@
+package polimorf
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ImperativeParadigm holds an imperative paradigm, keyed as pkg/verb's
+// ImperativeParadigm.
+type ImperativeParadigm struct {
+	Infinitive string `json:"infinitive"`
+	Sg2        string `json:"sg2"` // ty - czytaj
+	Pl1        string `json:"pl1"` // my - czytajmy
+	Pl2        string `json:"pl2"` // wy - czytajcie
+	Aspect     string `json:"aspect"`
+}
+
+// imperativeForm is one impt form: its slot (sg2, pl1 or pl2) and aspect.
+type imperativeForm struct {
+	form, slot, aspect string
+}
+
+// parseImperativeTag parses an impt tag (impt:NUMBER:PERSON:ASPECT),
+// reporting the slot and aspect.
+func parseImperativeTag(tags string) (slot, aspect string, ok bool) {
+	parts := strings.Split(tags, ":")
+	i := slices.Index(parts, "impt")
+	if i < 0 || len(parts) < i+4 {
+		return "", "", false
+	}
+	switch parts[i+1] + ":" + parts[i+2] {
+	case "sg:sec":
+		slot = "sg2"
+	case "pl:pri":
+		slot = "pl1"
+	case "pl:sec":
+		slot = "pl2"
+	default:
+		return "", "", false
+	}
+	return slot, parts[i+3], true
+}
+
+// extractImperativeParadigms groups the impt forms of one infinitive into
+// paradigms. The plurals are built on the 2sg (czytaj, czytajmy,
+// czytajcie), so each 2sg variant starts its own paradigm and takes the
+// plurals spelled on it; a variant without them keeps the slots empty.
+func extractImperativeParadigms(infinitive string, forms []imperativeForm) []ImperativeParadigm {
+	var paradigms []ImperativeParadigm
+	for _, sg2 := range forms {
+		if sg2.slot != "sg2" || slices.ContainsFunc(paradigms, func(p ImperativeParadigm) bool {
+			return p.Sg2 == sg2.form && p.Aspect == sg2.aspect
+		}) {
+			continue
+		}
+		p := ImperativeParadigm{Infinitive: infinitive, Sg2: sg2.form, Aspect: sg2.aspect}
+		for _, f := range forms {
+			switch {
+			case f.aspect != sg2.aspect:
+			case f.slot == "pl1" && f.form == sg2.form+"my":
+				p.Pl1 = f.form
+			case f.slot == "pl2" && f.form == sg2.form+"cie":
+				p.Pl2 = f.form
+			}
+		}
+		paradigms = append(paradigms, p)
+	}
+	return paradigms
+}
+
+// sortImperatives sorts imperative paradigms by infinitive, 2sg and aspect.
+func sortImperatives(paradigms []ImperativeParadigm) {
+	sort.Slice(paradigms, func(i, j int) bool {
+		a, b := paradigms[i], paradigms[j]
+		if a.Infinitive != b.Infinitive {
+			return a.Infinitive < b.Infinitive
+		}
+		if a.Sg2 != b.Sg2 {
+			return a.Sg2 < b.Sg2
+		}
+		return a.Aspect < b.Aspect
+	})
+}
+
+// ImperativeInfinitives returns the number of distinct infinitives with an
+// imperative paradigm.
+func (c *Corpora) ImperativeInfinitives() int {
+	infinitives := make(map[string]bool)
+	for _, p := range c.Imperatives {
+		infinitives[p.Infinitive] = true
+	}
+	return len(infinitives)
+}
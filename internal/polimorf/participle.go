@@ -0,0 +1,326 @@
+package polimorf
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// AdjectiveCase holds one case of an adjectival participle, by gender and
+// number, keyed as pkg/verb's AdjectiveCase. SgMInan is set in the
+// accusative only, where inanimate masculine nouns take the nominative
+// form (czytany list, but czytanego psa).
+type AdjectiveCase struct {
+	SgM     string `json:"sgM"`
+	SgMInan string `json:"sgMInan,omitempty"`
+	SgF     string `json:"sgF"`
+	SgN     string `json:"sgN"`
+	PlV     string `json:"plV"`  // masculine-personal plural
+	PlNV    string `json:"plNV"` // non-masculine-personal plural
+}
+
+// ParticipleParadigm holds the affirmative declension of an adjectival
+// participle: pact (czytający) or ppas (czytany).
+type ParticipleParadigm struct {
+	Infinitive string        `json:"infinitive"`
+	Kind       string        `json:"kind"` // "pact" or "ppas"
+	Aspect     string        `json:"aspect"`
+	Nom        AdjectiveCase `json:"nom"`
+	Gen        AdjectiveCase `json:"gen"`
+	Dat        AdjectiveCase `json:"dat"`
+	Acc        AdjectiveCase `json:"acc"`
+	Ins        AdjectiveCase `json:"ins"`
+	Loc        AdjectiveCase `json:"loc"`
+	Voc        AdjectiveCase `json:"voc"`
+}
+
+// AdverbialEntry holds an adverbial participle: pcon (czytając) or pant
+// (przeczytawszy).
+type AdverbialEntry struct {
+	Infinitive string `json:"infinitive"`
+	Kind       string `json:"kind"` // "pcon" or "pant"
+	Aspect     string `json:"aspect"`
+	Form       string `json:"form"`
+}
+
+// NounCases holds the seven cases of one number of a noun.
+type NounCases struct {
+	Nom string `json:"nom"`
+	Gen string `json:"gen"`
+	Dat string `json:"dat"`
+	Acc string `json:"acc"`
+	Ins string `json:"ins"`
+	Loc string `json:"loc"`
+	Voc string `json:"voc"`
+}
+
+// GerundParadigm holds the affirmative declension of a gerund (verbal
+// noun): czytanie, czytania, czytaniu...
+type GerundParadigm struct {
+	Infinitive string    `json:"infinitive"`
+	Aspect     string    `json:"aspect"`
+	Sg         NounCases `json:"sg"`
+	Pl         NounCases `json:"pl"`
+}
+
+// inflectedTag is a parsed declinable participle or gerund tag:
+// KIND:NUMBER:CASES:GENDERS:ASPECT:NEGATION, as in
+// verb:pact:sg:nom.voc:m1.m2.m3:imperf:aff:nonrefl.
+type inflectedTag struct {
+	kind    string
+	number  string   // sg or pl
+	cases   []string // nom, gen, dat, acc, inst, loc, voc
+	genders string   // dotted list: m1.m2.m3, f, n1.n2, m2.m3.f.n1.n2.p2.p3...
+	aspect  string
+	aff     bool
+}
+
+// parseInflectedTag finds kind among the colon-separated tags and parses
+// the fields after it. It reports false when kind is missing or the tag
+// is too short.
+func parseInflectedTag(tags, kind string) (inflectedTag, bool) {
+	parts := strings.Split(tags, ":")
+	i := slices.Index(parts, kind)
+	if i < 0 || len(parts) < i+6 {
+		return inflectedTag{}, false
+	}
+	return inflectedTag{
+		kind:    kind,
+		number:  parts[i+1],
+		cases:   strings.Split(parts[i+2], "."),
+		genders: parts[i+3],
+		aspect:  parts[i+4],
+		aff:     parts[i+5] == "aff",
+	}, true
+}
+
+// parseAdverbialTag parses a pcon or pant tag (KIND:ASPECT), reporting
+// the kind and aspect.
+func parseAdverbialTag(tags string) (kind, aspect string, ok bool) {
+	parts := strings.Split(tags, ":")
+	for i, p := range parts {
+		if (p == "pcon" || p == "pant") && i+1 < len(parts) {
+			return p, parts[i+1], true
+		}
+	}
+	return "", "", false
+}
+
+// participleCollector groups participle and gerund forms by infinitive,
+// kind and aspect as Extract reads them, keeping the first form seen for
+// each slot.
+type participleCollector struct {
+	participles map[[3]string]*ParticipleParadigm
+	gerunds     map[[2]string]*GerundParadigm
+	adverbials  map[[3]string]*AdverbialEntry
+}
+
+func newParticipleCollector() *participleCollector {
+	return &participleCollector{
+		participles: make(map[[3]string]*ParticipleParadigm),
+		gerunds:     make(map[[2]string]*GerundParadigm),
+		adverbials:  make(map[[3]string]*AdverbialEntry),
+	}
+}
+
+// addParticiple records an affirmative pact or ppas form.
+func (pc *participleCollector) addParticiple(lemma, form string, t inflectedTag) {
+	if !t.aff {
+		return
+	}
+	key := [3]string{lemma, t.kind, t.aspect}
+	p, ok := pc.participles[key]
+	if !ok {
+		p = &ParticipleParadigm{Infinitive: lemma, Kind: t.kind, Aspect: t.aspect}
+		pc.participles[key] = p
+	}
+	for _, c := range t.cases {
+		ac := p.adjectiveCase(c)
+		if ac == nil {
+			continue
+		}
+		for _, slot := range adjectiveSlots(ac, t.number, t.genders, c == "acc") {
+			if *slot == "" {
+				*slot = form
+			}
+		}
+	}
+}
+
+// addGerund records an affirmative gerund form.
+func (pc *participleCollector) addGerund(lemma, form string, t inflectedTag) {
+	if !t.aff {
+		return
+	}
+	key := [2]string{lemma, t.aspect}
+	g, ok := pc.gerunds[key]
+	if !ok {
+		g = &GerundParadigm{Infinitive: lemma, Aspect: t.aspect}
+		pc.gerunds[key] = g
+	}
+	cases := &g.Sg
+	if t.number == "pl" {
+		cases = &g.Pl
+	}
+	for _, c := range t.cases {
+		if slot := cases.slot(c); slot != nil && *slot == "" {
+			*slot = form
+		}
+	}
+}
+
+// addAdverbial records a pcon or pant form.
+func (pc *participleCollector) addAdverbial(lemma, form, kind, aspect string) {
+	key := [3]string{lemma, kind, aspect}
+	if _, ok := pc.adverbials[key]; !ok {
+		pc.adverbials[key] = &AdverbialEntry{Infinitive: lemma, Kind: kind, Aspect: aspect, Form: form}
+	}
+}
+
+// adjectiveCase returns the case named by a Polimorf case tag.
+func (p *ParticipleParadigm) adjectiveCase(c string) *AdjectiveCase {
+	switch c {
+	case "nom":
+		return &p.Nom
+	case "gen":
+		return &p.Gen
+	case "dat":
+		return &p.Dat
+	case "acc":
+		return &p.Acc
+	case "inst":
+		return &p.Ins
+	case "loc":
+		return &p.Loc
+	case "voc":
+		return &p.Voc
+	}
+	return nil
+}
+
+// slot returns the case named by a Polimorf case tag.
+func (n *NounCases) slot(c string) *string {
+	switch c {
+	case "nom":
+		return &n.Nom
+	case "gen":
+		return &n.Gen
+	case "dat":
+		return &n.Dat
+	case "acc":
+		return &n.Acc
+	case "inst":
+		return &n.Ins
+	case "loc":
+		return &n.Loc
+	case "voc":
+		return &n.Voc
+	}
+	return nil
+}
+
+// adjectiveSlots returns the slots of ac a form tagged with number and
+// genders fills, normalizing gender tags as normalizeGenderSlots does:
+// m1/m2 (and m3 outside the accusative) are masculine, n1/n2 neuter; in
+// the plural m1 and p1 are virile and everything else non-virile.
+func adjectiveSlots(ac *AdjectiveCase, number, genders string, acc bool) []*string {
+	var slots []*string
+	has := func(g string) bool { return slices.Contains(strings.Split(genders, "."), g) }
+	if number == "sg" {
+		if has("m1") || has("m2") || (!acc && has("m3")) {
+			slots = append(slots, &ac.SgM)
+		}
+		if acc && has("m3") {
+			slots = append(slots, &ac.SgMInan)
+		}
+		if has("f") {
+			slots = append(slots, &ac.SgF)
+		}
+		if has("n") || has("n1") || has("n2") {
+			slots = append(slots, &ac.SgN)
+		}
+		return slots
+	}
+	if has("m1") || has("p1") {
+		slots = append(slots, &ac.PlV)
+	}
+	if has("m2") || has("m3") || has("f") || has("n") || has("n1") || has("n2") || has("p2") || has("p3") {
+		slots = append(slots, &ac.PlNV)
+	}
+	return slots
+}
+
+// results returns the collected paradigms with a nominative form, sorted.
+func (pc *participleCollector) results() ([]ParticipleParadigm, []AdverbialEntry, []GerundParadigm) {
+	var participles []ParticipleParadigm
+	for _, p := range pc.participles {
+		if p.Nom.SgM != "" {
+			participles = append(participles, *p)
+		}
+	}
+	sort.Slice(participles, func(i, j int) bool {
+		a, b := participles[i], participles[j]
+		if a.Infinitive != b.Infinitive {
+			return a.Infinitive < b.Infinitive
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Aspect < b.Aspect
+	})
+
+	var adverbials []AdverbialEntry
+	for _, a := range pc.adverbials {
+		adverbials = append(adverbials, *a)
+	}
+	sort.Slice(adverbials, func(i, j int) bool {
+		a, b := adverbials[i], adverbials[j]
+		if a.Infinitive != b.Infinitive {
+			return a.Infinitive < b.Infinitive
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Aspect < b.Aspect
+	})
+
+	var gerunds []GerundParadigm
+	for _, g := range pc.gerunds {
+		if g.Sg.Nom != "" {
+			gerunds = append(gerunds, *g)
+		}
+	}
+	sort.Slice(gerunds, func(i, j int) bool {
+		if gerunds[i].Infinitive != gerunds[j].Infinitive {
+			return gerunds[i].Infinitive < gerunds[j].Infinitive
+		}
+		return gerunds[i].Aspect < gerunds[j].Aspect
+	})
+
+	return participles, adverbials, gerunds
+}
+
+// ParticipleCorpus is the JSON shape of the participles corpus: the
+// adjectival paradigms next to the adverbial forms.
+type ParticipleCorpus struct {
+	Adjectival []ParticipleParadigm `json:"adjectival"`
+	Adverbial  []AdverbialEntry     `json:"adverbial"`
+}
+
+// ParticipleCorpus returns the participles in their corpus shape.
+func (c *Corpora) ParticipleCorpus() ParticipleCorpus {
+	return ParticipleCorpus{Adjectival: c.Participles, Adverbial: c.Adverbials}
+}
+
+// ParticipleInfinitives returns the number of distinct infinitives with
+// an adjectival or adverbial participle.
+func (c *Corpora) ParticipleInfinitives() int {
+	infinitives := make(map[string]bool)
+	for _, p := range c.Participles {
+		infinitives[p.Infinitive] = true
+	}
+	for _, a := range c.Adverbials {
+		infinitives[a.Infinitive] = true
+	}
+	return len(infinitives)
+}
@@ -0,0 +1,277 @@
+package polimorf
+
+import "strings"
+
+// parsePastForm extracts grammatical information from Polimorf past tense tags.
+// Tags format: verb:praet:NUMBER:GENDER:PERSON:ASPECT:REFL
+// Example: verb:praet:sg:m1:pri:imperf:nonrefl
+func parsePastForm(form, tags string) VerbForm {
+	vf := VerbForm{Form: form}
+
+	tagParts := strings.Split(tags, ":")
+	if len(tagParts) < 6 {
+		return vf
+	}
+
+	vf.Number = tagParts[2] // sg or pl
+	vf.Gender = tagParts[3] // m1, m2, m3, f, n, n1
+	vf.Person = tagParts[4] // pri, sec, ter
+
+	// Extract aspect
+	if strings.Contains(tags, ":imperf") {
+		vf.Aspect = "imperf"
+	} else if strings.Contains(tags, ":perf") {
+		vf.Aspect = "perf"
+	}
+
+	// Extract reflexivity
+	if strings.Contains(tags, ":refl.nonrefl") {
+		vf.Refl = "refl.nonrefl"
+	} else if strings.Contains(tags, ":nonrefl") {
+		vf.Refl = "nonrefl"
+	} else if strings.Contains(tags, ":refl") {
+		vf.Refl = "refl"
+	}
+
+	return vf
+}
+
+// extractPastParadigms groups past tense forms into coherent paradigms.
+// Past tense is simpler than present - stems are nearly universal within a verb,
+// so we mostly just need to collect all 13 forms whose stems match the sg3m.
+func extractPastParadigms(infinitive string, forms []VerbForm) []PastParadigm {
+	// Group forms by normalized slot (person+number+genderCategory)
+	// Polimorf uses compound gender tags like "m1.m2.m3", "n1.n2", "m1.p1", "m2.m3.f.n1.n2.p2.p3"
+	// We normalize these to: sgM, sgF, sgN, plV, plNV
+	bySlot := make(map[string][]VerbForm)
+	for _, f := range forms {
+		slots := normalizeGenderSlots(f.Number, f.Person, f.Gender)
+		for _, slot := range slots {
+			bySlot[slot] = append(bySlot[slot], f)
+		}
+	}
+
+	// Get the 3rd person masculine singular as base (it's the "dictionary" form)
+	sg3mForms := bySlot["sg:ter:M"]
+	if len(sg3mForms) == 0 {
+		return nil // No base form found
+	}
+
+	// For past tense, we try to build paradigms from each sg3m form
+	var paradigms []PastParadigm
+
+	for _, sg3m := range sg3mForms {
+		bySlot := coherentPastSlots(bySlot, sg3m.Form)
+		paradigm := PastParadigm{
+			Infinitive: infinitive,
+			Aspect:     sg3m.Aspect,
+		}
+
+		// Try to find all forms, preferring forms from the same aspect
+		paradigm.Sg1M = findPastFormNorm(bySlot, "sg", "pri", "M", sg3m.Aspect)
+		paradigm.Sg1F = findPastFormNorm(bySlot, "sg", "pri", "F", sg3m.Aspect)
+		paradigm.Sg2M = findPastFormNorm(bySlot, "sg", "sec", "M", sg3m.Aspect)
+		paradigm.Sg2F = findPastFormNorm(bySlot, "sg", "sec", "F", sg3m.Aspect)
+		paradigm.Sg3M = sg3m.Form
+		paradigm.Sg3F = findPastFormNorm(bySlot, "sg", "ter", "F", sg3m.Aspect)
+		paradigm.Sg3N = findPastFormNorm(bySlot, "sg", "ter", "N", sg3m.Aspect)
+		paradigm.Pl1V = findPastFormNorm(bySlot, "pl", "pri", "V", sg3m.Aspect)
+		paradigm.Pl1NV = findPastFormNorm(bySlot, "pl", "pri", "NV", sg3m.Aspect)
+		paradigm.Pl2V = findPastFormNorm(bySlot, "pl", "sec", "V", sg3m.Aspect)
+		paradigm.Pl2NV = findPastFormNorm(bySlot, "pl", "sec", "NV", sg3m.Aspect)
+		paradigm.Pl3V = findPastFormNorm(bySlot, "pl", "ter", "V", sg3m.Aspect)
+		paradigm.Pl3NV = findPastFormNorm(bySlot, "pl", "ter", "NV", sg3m.Aspect)
+
+		// Check if paradigm is complete (has all 13 forms)
+		if isCompletePastParadigm(paradigm) {
+			// Check for coherence - the stem should be consistent
+			if isPastParadigmCoherent(paradigm) {
+				paradigms = append(paradigms, paradigm)
+			}
+		}
+	}
+
+	return paradigms
+}
+
+// normalizeGenderSlots converts Polimorf compound gender tags to normalized slots.
+// Returns a list of slots this form belongs to.
+// Polimorf tags:
+//   - Singular: m1.m2.m3 (masc), f (fem), n1.n2 (neut)
+//   - Plural: m1.p1 (masc-pers/virile), m2.m3.f.n1.n2.p2.p3 (non-masc-pers)
+func normalizeGenderSlots(number, person, gender string) []string {
+	var slots []string
+	slot := number + ":" + person + ":"
+
+	// Check for masculine (singular or plural virile)
+	if strings.Contains(gender, "m1") {
+		if number == "sg" {
+			slots = append(slots, slot+"M")
+		} else {
+			// In plural, m1 alone or m1.p1 means virile
+			if strings.Contains(gender, "p1") || gender == "m1" || !strings.Contains(gender, "m2") {
+				slots = append(slots, slot+"V")
+			}
+		}
+	}
+
+	// Check for feminine
+	if strings.Contains(gender, "f") {
+		if number == "sg" {
+			slots = append(slots, slot+"F")
+		}
+		// In plural, f is part of non-virile
+	}
+
+	// Check for neuter
+	if strings.Contains(gender, "n1") || strings.Contains(gender, "n2") {
+		if number == "sg" {
+			slots = append(slots, slot+"N")
+		}
+	}
+
+	// Check for plural non-virile (contains m2, m3, f, n1, n2, p2, p3 but not just m1.p1)
+	if number == "pl" {
+		if strings.Contains(gender, "m2") || strings.Contains(gender, "m3") ||
+			strings.Contains(gender, "f") || strings.Contains(gender, "p2") ||
+			strings.Contains(gender, "p3") {
+			slots = append(slots, slot+"NV")
+		}
+	}
+
+	return slots
+}
+
+// findPastFormNorm finds a form matching the given normalized slot.
+func findPastFormNorm(bySlot map[string][]VerbForm, number, person, genderCat, preferAspect string) string {
+	slot := number + ":" + person + ":" + genderCat
+	forms := bySlot[slot]
+	// Prefer matching aspect
+	for _, f := range forms {
+		if f.Aspect == preferAspect {
+			return f.Form
+		}
+	}
+	// Fall back to any form
+	if len(forms) > 0 {
+		return forms[0].Form
+	}
+	return ""
+}
+
+// isCompletePastParadigm checks if all 13 forms are present.
+func isCompletePastParadigm(p PastParadigm) bool {
+	return p.Sg1M != "" && p.Sg1F != "" &&
+		p.Sg2M != "" && p.Sg2F != "" &&
+		p.Sg3M != "" && p.Sg3F != "" && p.Sg3N != "" &&
+		p.Pl1V != "" && p.Pl1NV != "" &&
+		p.Pl2V != "" && p.Pl2NV != "" &&
+		p.Pl3V != "" && p.Pl3NV != ""
+}
+
+// pastEndings are the endings of each normalized past slot, added to the
+// stem: niosł-em, niosł-a, nieś-liśmy.
+var pastEndings = map[string]string{
+	"sg:pri:M": "łem", "sg:pri:F": "łam",
+	"sg:sec:M": "łeś", "sg:sec:F": "łaś",
+	"sg:ter:M": "ł", "sg:ter:F": "ła", "sg:ter:N": "ło",
+	"pl:pri:V": "liśmy", "pl:pri:NV": "łyśmy",
+	"pl:sec:V": "liście", "pl:sec:NV": "łyście",
+	"pl:ter:V": "li", "pl:ter:NV": "ły",
+}
+
+// pastAlternations are the stem alternations allowed within one past
+// paradigm, applied in order to reduce a stem to a skeleton that every
+// form of the paradigm shares:
+//   - ó → o: mógł, mogła
+//   - ą → ę: wziął, wzięła
+//   - o → e, e → a: niosła, nieśli; jadł, jedli (so a, e and o all match)
+//   - ś → s, ź → z, ń → n: the virile softening of niósł → nieśli,
+//     wiózł → wieźli
+//
+// No other consonant alternates, so the two stems of a homograph (paść:
+// padł, fell; pasł, grazed) stay apart.
+var pastAlternations = []struct{ from, to string }{
+	{"ó", "o"},
+	{"ą", "ę"},
+	{"o", "e"},
+	{"e", "a"},
+	{"ś", "s"},
+	{"ź", "z"},
+	{"ń", "n"},
+}
+
+// pastStemSkeleton reduces a past stem to the form shared by its whole
+// paradigm. The suppletive stems of iść, szed- (szedł) and sz- (szła),
+// and the fleeting e of schnąć, sech- (sechł) and sch- (schła), are
+// unified first, along with the e a prefix takes before them (wszedł,
+// weszła; obsechł, obeschła). After the alternations, the -ną-/-nę- of -nąć verbs is
+// dropped, as many of them lose it in some forms: cuchnął, cuchł,
+// cuchnęła, cuchli.
+func pastStemSkeleton(stem string) string {
+	for _, fleeting := range [][2]string{{"szed", "sz"}, {"sech", "sch"}} {
+		if prefix, ok := strings.CutSuffix(stem, fleeting[0]); ok {
+			stem = prefix + fleeting[1]
+		}
+		if prefix, ok := strings.CutSuffix(stem, "e"+fleeting[1]); ok {
+			stem = prefix + fleeting[1]
+		}
+	}
+	for _, alt := range pastAlternations {
+		stem = strings.ReplaceAll(stem, alt.from, alt.to)
+	}
+	return strings.TrimSuffix(stem, "nę")
+}
+
+// pastFormSkeleton returns the stem skeleton of a form in the given
+// normalized slot, or false when the form lacks the slot's ending.
+func pastFormSkeleton(form, slot string) (string, bool) {
+	stem, ok := strings.CutSuffix(form, pastEndings[slot])
+	if !ok || stem == "" {
+		return "", false
+	}
+	return pastStemSkeleton(stem), true
+}
+
+// isPastParadigmCoherent checks that every form of a past paradigm has
+// its slot's ending on a stem that differs from the sg3m stem only by the
+// alternations in pastAlternations. A paradigm mixing two stems, like
+// padł with pasła, is rejected.
+func isPastParadigmCoherent(p PastParadigm) bool {
+	base, ok := pastFormSkeleton(p.Sg3M, "sg:ter:M")
+	if !ok {
+		return false
+	}
+	forms := map[string]string{
+		"sg:pri:M": p.Sg1M, "sg:pri:F": p.Sg1F,
+		"sg:sec:M": p.Sg2M, "sg:sec:F": p.Sg2F,
+		"sg:ter:F": p.Sg3F, "sg:ter:N": p.Sg3N,
+		"pl:pri:V": p.Pl1V, "pl:pri:NV": p.Pl1NV,
+		"pl:sec:V": p.Pl2V, "pl:sec:NV": p.Pl2NV,
+		"pl:ter:V": p.Pl3V, "pl:ter:NV": p.Pl3NV,
+	}
+	for slot, form := range forms {
+		if skeleton, ok := pastFormSkeleton(form, slot); !ok || skeleton != base {
+			return false
+		}
+	}
+	return true
+}
+
+// coherentPastSlots returns the forms of bySlot whose stems match sg3m,
+// so that a homograph's paradigms do not borrow each other's forms.
+func coherentPastSlots(bySlot map[string][]VerbForm, sg3m string) map[string][]VerbForm {
+	base, ok := pastFormSkeleton(sg3m, "sg:ter:M")
+	if !ok {
+		return bySlot
+	}
+	coherent := make(map[string][]VerbForm, len(bySlot))
+	for slot, forms := range bySlot {
+		for _, f := range forms {
+			if skeleton, ok := pastFormSkeleton(f.Form, slot); ok && skeleton == base {
+				coherent[slot] = append(coherent[slot], f)
+			}
+		}
+	}
+	return coherent
+}
@@ -0,0 +1,95 @@
+package polimorf
+
+import (
+	"strings"
+	"testing"
+)
+
+// pastParadigm builds a paradigm from its 13 forms in PastParadigm order.
+func pastParadigm(infinitive, forms string) PastParadigm {
+	f := strings.Fields(forms)
+	return PastParadigm{
+		Infinitive: infinitive,
+		Sg1M:       f[0], Sg1F: f[1], Sg2M: f[2], Sg2F: f[3],
+		Sg3M: f[4], Sg3F: f[5], Sg3N: f[6],
+		Pl1V: f[7], Pl1NV: f[8], Pl2V: f[9], Pl2NV: f[10], Pl3V: f[11], Pl3NV: f[12],
+	}
+}
+
+func TestIsPastParadigmCoherent(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		forms      string
+		want       bool
+	}{
+		{"czytać", "czytałem czytałam czytałeś czytałaś czytał czytała czytało czytaliśmy czytałyśmy czytaliście czytałyście czytali czytały", true},
+		// ó → o, o → e and the virile ś: niósł, niosła, nieśli
+		{"nieść", "niosłem niosłam niosłeś niosłaś niósł niosła niosło nieśliśmy niosłyśmy nieśliście niosłyście nieśli niosły", true},
+		// ą → ę: wziął, wzięła
+		{"wziąć", "wziąłem wzięłam wziąłeś wzięłaś wziął wzięła wzięło wzięliśmy wzięłyśmy wzięliście wzięłyście wzięli wzięły", true},
+		// a → e: jadł, jedli
+		{"jeść", "jadłem jadłam jadłeś jadłaś jadł jadła jadło jedliśmy jadłyśmy jedliście jadłyście jedli jadły", true},
+		// suppletive szed-/sz- with the prefix e: wszedł, weszła
+		{"wejść", "wszedłem weszłam wszedłeś weszłaś wszedł weszła weszło weszliśmy weszłyśmy weszliście weszłyście weszli weszły", true},
+		// -nąć verbs dropping the n: cuchł, cuchnęła
+		{"cuchnąć", "cuchnąłem cuchnęłam cuchnąłeś cuchnęłaś cuchł cuchnęła cuchnęło cuchliśmy cuchnęłyśmy cuchliście cuchnęłyście cuchli cuchnęły", true},
+		// fleeting e: sechł, schła
+		{"schnąć", "schłem schłam schłeś schłaś sechł schła schło schliśmy schłyśmy schliście schłyście schli schły", true},
+
+		// paść mixing pasł (grazed) with padła (fell)
+		{"paść", "padłem padłam padłeś padłaś pasł padła padło padliśmy padłyśmy padliście padłyście padli padły", false},
+		// a form without its slot's ending
+		{"czytać", "czytałem czytałam czytałeś czytałaś czytał czytała czytało czytaliśmy czytałyśmy czytaliście czytałyście czytały czytały", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			if got := isPastParadigmCoherent(pastParadigm(tt.infinitive, tt.forms)); got != tt.want {
+				t.Errorf("isPastParadigmCoherent(%s) = %v, want %v", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestExtractPastSplitsMixedStems checks that the two paradigms of paść
+// come out clean when Polimorf lists the forms of both readings.
+func TestExtractPastSplitsMixedStems(t *testing.T) {
+	var lines []string
+	for _, stem := range []string{"pad", "pas"} {
+		virile := stem
+		if stem == "pas" {
+			virile = "paś"
+		}
+		lines = append(lines,
+			"paść;"+stem+"łem;verb:praet:sg:m1.m2.m3:pri:perf",
+			"paść;"+stem+"łam;verb:praet:sg:f:pri:perf",
+			"paść;"+stem+"łeś;verb:praet:sg:m1.m2.m3:sec:perf",
+			"paść;"+stem+"łaś;verb:praet:sg:f:sec:perf",
+			"paść;"+stem+"ł;verb:praet:sg:m1.m2.m3:ter:perf",
+			"paść;"+stem+"ła;verb:praet:sg:f:ter:perf",
+			"paść;"+stem+"ło;verb:praet:sg:n1.n2:ter:perf",
+			"paść;"+virile+"liśmy;verb:praet:pl:m1.p1:pri:perf",
+			"paść;"+stem+"łyśmy;verb:praet:pl:m2.m3.f.n1.n2.p2.p3:pri:perf",
+			"paść;"+virile+"liście;verb:praet:pl:m1.p1:sec:perf",
+			"paść;"+stem+"łyście;verb:praet:pl:m2.m3.f.n1.n2.p2.p3:sec:perf",
+			"paść;"+virile+"li;verb:praet:pl:m1.p1:ter:perf",
+			"paść;"+stem+"ły;verb:praet:pl:m2.m3.f.n1.n2.p2.p3:ter:perf",
+		)
+	}
+
+	c, err := Extract(strings.NewReader(strings.Join(lines, "\n")), Options{Tenses: []Tense{TensePast}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Past) != 2 {
+		t.Fatalf("got %d paradigms, want 2: %+v", len(c.Past), c.Past)
+	}
+	for _, p := range c.Past {
+		if !isPastParadigmCoherent(p) {
+			t.Errorf("incoherent paradigm: %+v", p)
+		}
+	}
+	if c.Past[0].Sg3F != "padła" || c.Past[1].Sg3F != "pasła" {
+		t.Errorf("Sg3F = %q, %q, want padła, pasła", c.Past[0].Sg3F, c.Past[1].Sg3F)
+	}
+}
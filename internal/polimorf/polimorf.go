@@ -0,0 +1,298 @@
+// Package polimorf extracts verb paradigms from Polimorf morphological dictionary data.
+//
+// # Problem This Solves
+//
+// Polimorf (the source data) contains multiple valid conjugation forms for many verbs:
+//   - Homographs: "stać" can mean "to stand" (stoję) or "to become" (stanę)
+//   - Variants: "brzmieć" has both standard (brzmię) and colloquial (brzmieję) forms
+//
+// A naive extraction that takes "first form seen" for each slot creates mixed paradigms
+// like: {sg1: brzmieję, sg2: brzmiejesz, sg3: brzmi} - mixing two different patterns!
+//
+// # Solution
+//
+// We extract ALL forms for each infinitive, then group them into coherent paradigms
+// based on Polish conjugation patterns. Forms belong to the same paradigm if their
+// endings are consistent with each other.
+//
+// # Polish Conjugation Pattern Primer
+//
+// Polish verbs conjugate in predictable patterns. The 1sg (ja) form determines
+// the pattern for all other forms:
+//
+//	Pattern A (-ę/-esz): piszę → piszesz, pisze, piszemy, piszecie, piszą
+//	Pattern B (-ę/-isz): robię → robisz, robi, robimy, robicie, robią
+//	Pattern C (-ę/-ysz): uczę → uczysz, uczy, uczymy, uczycie, uczą
+//	Pattern D (-am/-asz): czytam → czytasz, czyta, czytamy, czytacie, czytają
+//	Pattern E (-em/-esz): umiem → umiesz, umie, umiemy, umiecie, umieją
+//	Pattern F (-eję/-ejesz): starzeję → starzejesz, starzeje, starzejemy, starzejecie, starzeją
+//
+// By checking if forms have compatible endings, we can group them correctly.
+package polimorf
+
+import (
+	"bufio"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Tense represents an extraction mode.
+type Tense string
+
+const (
+	TensePresent    Tense = "present"
+	TensePast       Tense = "past"
+	TenseVerbalNoun Tense = "verbal_noun"
+	TenseImperative Tense = "imperative"
+	TenseParticiple Tense = "participles" // adjectival (pact, ppas) and adverbial (pcon, pant)
+	TenseGerund     Tense = "gerund"      // full gerund declension (ger)
+)
+
+// VerbForm represents a single conjugated form with its grammatical tags.
+type VerbForm struct {
+	Form   string
+	Number string // "sg" or "pl"
+	Person string // "pri" (1st), "sec" (2nd), "ter" (3rd)
+	Gender string // "m1" (masc.pers), "m2" (masc.anim), "m3" (masc.inan), "f", "n", "n1" (non-masc.pers plural)
+	Aspect string // "imperf" or "perf"
+	Refl   string // reflexivity tag
+}
+
+// VerbParadigm holds a complete present tense paradigm.
+type VerbParadigm struct {
+	Infinitive string `json:"infinitive"`
+	Sg1        string `json:"sg1"` // ja
+	Sg2        string `json:"sg2"` // ty
+	Sg3        string `json:"sg3"` // on/ona/ono
+	Pl1        string `json:"pl1"` // my
+	Pl2        string `json:"pl2"` // wy
+	Pl3        string `json:"pl3"` // oni/one
+	Aspect     string `json:"aspect"`
+}
+
+// PastParadigm holds a complete past tense paradigm (13 forms).
+// Past tense distinguishes gender: masculine/feminine/neuter in singular,
+// masculine-personal/non-masculine-personal in plural.
+type PastParadigm struct {
+	Infinitive string `json:"infinitive"`
+	// Singular - ja (1st person)
+	Sg1M string `json:"sg1m"` // ja (masculine)
+	Sg1F string `json:"sg1f"` // ja (feminine)
+	// Singular - ty (2nd person)
+	Sg2M string `json:"sg2m"` // ty (masculine)
+	Sg2F string `json:"sg2f"` // ty (feminine)
+	// Singular - on/ona/ono (3rd person)
+	Sg3M string `json:"sg3m"` // on (masculine)
+	Sg3F string `json:"sg3f"` // ona (feminine)
+	Sg3N string `json:"sg3n"` // ono (neuter)
+	// Plural - my (1st person)
+	Pl1V  string `json:"pl1v"`  // my (masculine-personal/virile)
+	Pl1NV string `json:"pl1nv"` // my (non-masculine-personal/non-virile)
+	// Plural - wy (2nd person)
+	Pl2V  string `json:"pl2v"`  // wy (masculine-personal)
+	Pl2NV string `json:"pl2nv"` // wy (non-masculine-personal)
+	// Plural - oni/one (3rd person)
+	Pl3V   string `json:"pl3v"`  // oni (masculine-personal)
+	Pl3NV  string `json:"pl3nv"` // one (non-masculine-personal)
+	Aspect string `json:"aspect"`
+}
+
+// VerbalNounEntry holds an infinitive and its verbal noun form.
+type VerbalNounEntry struct {
+	Infinitive string `json:"infinitive"`
+	VerbalNoun string `json:"verbal_noun"`
+}
+
+// Options controls what Extract collects.
+type Options struct {
+	// Tenses lists the corpora to extract. Empty means all of them.
+	Tenses []Tense
+	// KeepArchaic keeps present paradigms that isArchaicParadigm would drop
+	// (szeptam, wykonywam, ...).
+	KeepArchaic bool
+	// ExcludeQualifiers drops forms carrying any of these SGJP qualifiers
+	// (daw, przest, rzad, gwar, ...) before any grouping. Only 5-column
+	// lines have qualifiers; 3-column lines are never dropped.
+	ExcludeQualifiers []string
+}
+
+// Corpora holds the paradigms extracted from one pass over Polimorf.
+// Each slice is sorted for deterministic output.
+type Corpora struct {
+	Present     []VerbParadigm
+	Past        []PastParadigm
+	VerbalNouns []VerbalNounEntry
+	Imperatives []ImperativeParadigm
+	Participles []ParticipleParadigm
+	Adverbials  []AdverbialEntry
+	Gerunds     []GerundParadigm
+
+	// Number of infinitives with any forms seen, before paradigm extraction.
+	PresentInfinitives int
+	PastInfinitives    int
+}
+
+// Extract reads Polimorf 3-column data ("lemma;form;tags" per line) or
+// SGJP 5-column data ("lemma;form;tags;category;qualifiers"), detecting
+// the column count per line, and extracts the requested corpora in a
+// single pass.
+func Extract(r io.Reader, opts Options) (*Corpora, error) {
+	want := make(map[Tense]bool)
+	for _, t := range opts.Tenses {
+		want[t] = true
+	}
+	if len(want) == 0 {
+		want[TensePresent], want[TensePast], want[TenseVerbalNoun] = true, true, true
+		want[TenseImperative] = true
+		want[TenseParticiple], want[TenseGerund] = true, true
+	}
+
+	// Collect ALL forms for each infinitive
+	presentForms := make(map[string][]VerbForm)
+	pastForms := make(map[string][]VerbForm)
+	imperativeForms := make(map[string][]imperativeForm)
+
+	// Use a set to deduplicate (infinitive, form) verbal noun pairs
+	type pair struct{ inf, form string }
+	seenVN := make(map[pair]bool)
+
+	participles := newParticipleCollector()
+
+	c := &Corpora{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		parts := strings.Split(line, ";")
+		switch len(parts) {
+		case 3:
+		case 5:
+			if hasQualifier(parts[4], opts.ExcludeQualifiers) {
+				continue
+			}
+		default:
+			continue
+		}
+		lemma, form, tags := parts[0], parts[1], parts[2]
+
+		switch {
+		case want[TensePresent] && strings.Contains(tags, "verb:fin:"):
+			vf := parseVerbForm(form, tags)
+			if vf.Number != "" && vf.Person != "" {
+				presentForms[lemma] = append(presentForms[lemma], vf)
+			}
+		case want[TensePast] && strings.Contains(tags, "verb:praet:"):
+			vf := parsePastForm(form, tags)
+			if vf.Number != "" && vf.Person != "" && vf.Gender != "" {
+				pastForms[lemma] = append(pastForms[lemma], vf)
+			}
+		case want[TenseImperative] && strings.Contains(tags, "verb:impt:"):
+			if slot, aspect, ok := parseImperativeTag(tags); ok {
+				imperativeForms[lemma] = append(imperativeForms[lemma], imperativeForm{form, slot, aspect})
+			}
+		case want[TenseVerbalNoun] && isVerbalNounTag(tags):
+			p := pair{lemma, form}
+			if seenVN[p] {
+				continue
+			}
+			seenVN[p] = true
+			c.VerbalNouns = append(c.VerbalNouns, VerbalNounEntry{Infinitive: lemma, VerbalNoun: form})
+		}
+
+		// Participles and gerunds are collected alongside the tenses above;
+		// a gerund nominative feeds both the verbal nouns and the gerunds.
+		if want[TenseParticiple] {
+			for _, kind := range []string{"pact", "ppas"} {
+				if t, ok := parseInflectedTag(tags, kind); ok {
+					participles.addParticiple(lemma, form, t)
+				}
+			}
+			if kind, aspect, ok := parseAdverbialTag(tags); ok {
+				participles.addAdverbial(lemma, form, kind, aspect)
+			}
+		}
+		if want[TenseGerund] {
+			if t, ok := parseInflectedTag(tags, "ger"); ok {
+				participles.addGerund(lemma, form, t)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Extract coherent paradigms from collected forms
+	for infinitive, forms := range presentForms {
+		c.Present = append(c.Present, extractCoherentParadigms(infinitive, forms, opts.KeepArchaic)...)
+	}
+	for infinitive, forms := range pastForms {
+		c.Past = append(c.Past, extractPastParadigms(infinitive, forms)...)
+	}
+	for infinitive, forms := range imperativeForms {
+		c.Imperatives = append(c.Imperatives, extractImperativeParadigms(infinitive, forms)...)
+	}
+	c.PresentInfinitives = len(presentForms)
+	c.PastInfinitives = len(pastForms)
+	c.Participles, c.Adverbials, c.Gerunds = participles.results()
+
+	// Sort for deterministic output
+	sort.Slice(c.Present, func(i, j int) bool {
+		if c.Present[i].Infinitive != c.Present[j].Infinitive {
+			return c.Present[i].Infinitive < c.Present[j].Infinitive
+		}
+		if c.Present[i].Sg1 != c.Present[j].Sg1 {
+			return c.Present[i].Sg1 < c.Present[j].Sg1
+		}
+		return c.Present[i].Aspect < c.Present[j].Aspect
+	})
+	sort.Slice(c.Past, func(i, j int) bool {
+		if c.Past[i].Infinitive != c.Past[j].Infinitive {
+			return c.Past[i].Infinitive < c.Past[j].Infinitive
+		}
+		return c.Past[i].Sg1M < c.Past[j].Sg1M
+	})
+	sortImperatives(c.Imperatives)
+	sort.Slice(c.VerbalNouns, func(i, j int) bool {
+		if c.VerbalNouns[i].Infinitive != c.VerbalNouns[j].Infinitive {
+			return c.VerbalNouns[i].Infinitive < c.VerbalNouns[j].Infinitive
+		}
+		return c.VerbalNouns[i].VerbalNoun < c.VerbalNouns[j].VerbalNoun
+	})
+
+	return c, nil
+}
+
+// isVerbalNounTag matches verbal noun nominative singular affirmative tags:
+// "ger:" with ":sg:", ":nom" and ":aff".
+func isVerbalNounTag(tags string) bool {
+	return strings.Contains(tags, "ger:") &&
+		strings.Contains(tags, ":sg:") && strings.Contains(tags, ":nom") && strings.Contains(tags, ":aff")
+}
+
+// VerbalNounInfinitives returns the number of distinct infinitives
+// among the extracted verbal noun entries.
+func (c *Corpora) VerbalNounInfinitives() int {
+	infinitives := make(map[string]bool)
+	for _, e := range c.VerbalNouns {
+		infinitives[e.Infinitive] = true
+	}
+	return len(infinitives)
+}
+
+// hasQualifier reports whether a 5-column qualifier field lists any of
+// excluded. The field holds qualifiers separated by "|" or ",", written
+// with or without their trailing dot: "daw.|pot." or "rzad".
+func hasQualifier(field string, excluded []string) bool {
+	if field == "" || len(excluded) == 0 {
+		return false
+	}
+	for _, q := range strings.FieldsFunc(field, func(r rune) bool { return r == '|' || r == ',' || r == ' ' }) {
+		if slices.Contains(excluded, strings.TrimSuffix(q, ".")) {
+			return true
+		}
+	}
+	return false
+}
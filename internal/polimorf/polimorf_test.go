@@ -0,0 +1,120 @@
+package polimorf
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+func extractFixture(t *testing.T, name string, opts Options) *Corpora {
+	t.Helper()
+	f, err := os.Open("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	c, err := Extract(f, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// sg1s returns the sg1 forms of the present paradigms extracted for an
+// infinitive.
+func sg1s(c *Corpora, infinitive string) []string {
+	var forms []string
+	for _, p := range c.Present {
+		if p.Infinitive == infinitive {
+			forms = append(forms, p.Sg1)
+		}
+	}
+	return forms
+}
+
+func TestExtractQualifiers(t *testing.T) {
+	tests := []struct {
+		name    string
+		exclude []string
+		want    map[string]int // infinitive -> number of present paradigms
+	}{
+		{"keep all", nil, map[string]int{"czytać": 1, "wykonywać": 2, "dawać": 2, "brzmieć": 1}},
+		{"drop archaic", []string{"daw"}, map[string]int{"czytać": 1, "wykonywać": 1, "dawać": 2, "brzmieć": 1}},
+		{"drop obsolete and colloquial", []string{"przest", "pot"}, map[string]int{"czytać": 1, "wykonywać": 2, "dawać": 1, "brzmieć": 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// KeepArchaic turns off the isArchaicParadigm heuristic, so
+			// only the qualifiers decide what is dropped.
+			c := extractFixture(t, "qualifiers.txt", Options{Tenses: []Tense{TensePresent}, KeepArchaic: true, ExcludeQualifiers: tt.exclude})
+			for infinitive, n := range tt.want {
+				if got := sg1s(c, infinitive); len(got) != n {
+					t.Errorf("%s: got paradigms %v, want %d", infinitive, got, n)
+				}
+			}
+		})
+	}
+
+	c := extractFixture(t, "qualifiers.txt", Options{Tenses: []Tense{TensePresent}, KeepArchaic: true, ExcludeQualifiers: []string{"daw", "przest"}})
+	if got := sg1s(c, "wykonywać"); len(got) != 1 || got[0] != "wykonuję" {
+		t.Errorf("wykonywać: got %v, want [wykonuję]", got)
+	}
+	if got := sg1s(c, "dawać"); len(got) != 1 || got[0] != "daję" {
+		t.Errorf("dawać: got %v, want [daję]", got)
+	}
+}
+
+func TestHasQualifier(t *testing.T) {
+	tests := []struct {
+		field string
+		want  bool
+	}{
+		{"", false},
+		{"pot.", false},
+		{"daw.", true},
+		{"rzad", true},
+		{"pot.|przest.", true},
+		{"pot.,gwar.", true},
+	}
+	excluded := []string{"daw", "przest", "rzad", "gwar"}
+	for _, tt := range tests {
+		if got := hasQualifier(tt.field, excluded); got != tt.want {
+			t.Errorf("hasQualifier(%q) = %v, want %v", tt.field, got, tt.want)
+		}
+	}
+}
+
+func TestExtractSplitsAspects(t *testing.T) {
+	c := extractFixture(t, "aspect.txt", Options{Tenses: []Tense{TensePresent}})
+	got := make(map[string][]string)
+	for _, p := range c.Present {
+		got[p.Infinitive] = append(got[p.Infinitive], p.Sg1+"/"+p.Pl3+"/"+p.Aspect)
+	}
+	want := map[string][]string{
+		// Forms tagged with both aspects make one paradigm.
+		"aresztować": {"aresztuję/aresztują/imperf"},
+		// The incomplete imperfective must not borrow the perfective's pl3.
+		"zbadać": {"zbadam/zbadają/perf"},
+	}
+	for infinitive, w := range want {
+		if !slices.Equal(got[infinitive], w) {
+			t.Errorf("%s: got %v, want %v", infinitive, got[infinitive], w)
+		}
+	}
+}
+
+func TestExtractImperatives(t *testing.T) {
+	c := extractFixture(t, "imperative.txt", Options{Tenses: []Tense{TenseImperative}})
+	want := []ImperativeParadigm{
+		{Infinitive: "czytać", Sg2: "czytaj", Pl1: "czytajmy", Pl2: "czytajcie", Aspect: "imperf"},
+		// Each 2sg variant takes the plurals built on it.
+		{Infinitive: "oczyścić", Sg2: "oczyszcz", Pl1: "oczyszczmy", Pl2: "oczyszczcie", Aspect: "perf"},
+		{Infinitive: "oczyścić", Sg2: "oczyść", Pl1: "oczyśćmy", Pl2: "oczyśćcie", Aspect: "perf"},
+	}
+	if !slices.Equal(c.Imperatives, want) {
+		t.Errorf("Imperatives = %+v, want %+v", c.Imperatives, want)
+	}
+	if len(c.Present) != 0 {
+		t.Errorf("Present = %+v, want nothing when only imperatives are requested", c.Present)
+	}
+}
@@ -0,0 +1,164 @@
+package verb
+
+import "strings"
+
+// AdjectiveCase is one case of an adjective declension: the three singular
+// genders and the two plural genders.
+//
+// In the accusative, SgM is the masculine animate form (czytanego: widzę
+// czytanego autora) and SgMInan the inanimate one (czytany: widzę czytany
+// list). The other cases leave SgMInan empty.
+type AdjectiveCase struct {
+	SgM     string `json:"sgm"`               // masculine - czytany
+	SgMInan string `json:"sgmInan,omitempty"` // masculine inanimate, accusative only - czytany
+	SgF     string `json:"sgf"`               // feminine - czytana
+	SgN     string `json:"sgn"`               // neuter - czytane
+	PlV     string `json:"plv"`               // masculine-personal/virile - czytani
+	PlNV    string `json:"plnv"`              // non-masculine-personal - czytane
+}
+
+// ParticipleDeclension is the full declension of an adjectival
+// participle, which agrees with its noun like any adjective: seven cases
+// by number and gender.
+type ParticipleDeclension struct {
+	Nom AdjectiveCase `json:"nom"` // mianownik - czytany
+	Gen AdjectiveCase `json:"gen"` // dopełniacz - czytanego
+	Dat AdjectiveCase `json:"dat"` // celownik - czytanemu
+	Acc AdjectiveCase `json:"acc"` // biernik - czytanego/czytany
+	Ins AdjectiveCase `json:"ins"` // narzędnik - czytanym
+	Loc AdjectiveCase `json:"loc"` // miejscownik - czytanym
+	Voc AdjectiveCase `json:"voc"` // wołacz - czytany
+}
+
+// DeclineParticiple declines an adjectival participle given in the
+// masculine nominative singular, as PassiveParticiple and
+// ActiveParticiple return it: czytany → czytanego, czytanej, czytani,
+// czytanych; czytający → czytającego, czytający. A trailing się is kept
+// on every form.
+//
+// Participles in -ony have -eni in the virile plural: noszony → noszeni,
+// niesiony → niesieni. A base that does not end in -y or -i is not an
+// adjective and gives an empty declension.
+func DeclineParticiple(base string) ParticipleDeclension {
+	if word, ok := splitReflexive(base); ok {
+		d := DeclineParticiple(word)
+		for _, c := range d.cases() {
+			c.withReflexive()
+		}
+		return d
+	}
+
+	d := declineAdjective(base)
+	if stem, ok := strings.CutSuffix(base, "ony"); ok && d.Nom.SgM != "" {
+		virile := stem + "eni"
+		d.Nom.PlV, d.Voc.PlV = virile, virile
+	}
+	return d
+}
+
+// cases returns pointers to the seven cases of a declension.
+func (d *ParticipleDeclension) cases() []*AdjectiveCase {
+	return []*AdjectiveCase{&d.Nom, &d.Gen, &d.Dat, &d.Acc, &d.Ins, &d.Loc, &d.Voc}
+}
+
+// withReflexive appends się to every non-empty form of a case.
+func (c *AdjectiveCase) withReflexive() {
+	for _, f := range []*string{&c.SgM, &c.SgMInan, &c.SgF, &c.SgN, &c.PlV, &c.PlNV} {
+		if *f != "" {
+			*f += reflexiveParticle
+		}
+	}
+}
+
+// declineAdjective declines an adjective of the -y/-a/-e pattern from its
+// masculine nominative singular. Three stem types share the endings and
+// differ in the vowel written before them:
+//   - hard stems take y and plain endings: czytany, czytana, czytanego
+//   - k and g stems take i before e and y: wielki, wielka, wielkiego
+//   - soft stems take i everywhere: tani, tania, taniego
+//
+// The virile nominative plural softens the last consonant instead (see
+// virileStem): czytani, wielcy, drodzy, tani.
+func declineAdjective(base string) ParticipleDeclension {
+	var stem, y, e, a string
+	switch {
+	case strings.HasSuffix(base, "ki") || strings.HasSuffix(base, "gi"):
+		stem, y, e = strings.TrimSuffix(base, "i"), "i", "i"
+	case strings.HasSuffix(base, "i"):
+		stem, y, e, a = strings.TrimSuffix(base, "i"), "i", "i", "i"
+	case strings.HasSuffix(base, "y"):
+		stem, y = strings.TrimSuffix(base, "y"), "y"
+	default:
+		return ParticipleDeclension{}
+	}
+
+	var virile string
+	if a != "" {
+		virile = stem + "i" // soft stems: tani → tani
+	} else {
+		virile = virileStem(stem)
+	}
+
+	nom := AdjectiveCase{
+		SgM: stem + y, SgF: stem + a + "a", SgN: stem + e + "e",
+		PlV: virile, PlNV: stem + e + "e",
+	}
+	return ParticipleDeclension{
+		Nom: nom,
+		Gen: AdjectiveCase{
+			SgM: stem + e + "ego", SgF: stem + e + "ej", SgN: stem + e + "ego",
+			PlV: stem + y + "ch", PlNV: stem + y + "ch",
+		},
+		Dat: AdjectiveCase{
+			SgM: stem + e + "emu", SgF: stem + e + "ej", SgN: stem + e + "emu",
+			PlV: stem + y + "m", PlNV: stem + y + "m",
+		},
+		Acc: AdjectiveCase{
+			SgM: stem + e + "ego", SgMInan: nom.SgM, SgF: stem + a + "ą", SgN: nom.SgN,
+			PlV: stem + y + "ch", PlNV: nom.PlNV,
+		},
+		Ins: AdjectiveCase{
+			SgM: stem + y + "m", SgF: stem + a + "ą", SgN: stem + y + "m",
+			PlV: stem + y + "mi", PlNV: stem + y + "mi",
+		},
+		Loc: AdjectiveCase{
+			SgM: stem + y + "m", SgF: stem + e + "ej", SgN: stem + y + "m",
+			PlV: stem + y + "ch", PlNV: stem + y + "ch",
+		},
+		Voc: nom,
+	}
+}
+
+// virileAlternations are the consonant changes of the virile nominative
+// plural of hard and velar stems, longest first. Consonants not listed
+// take -i: czytan → czytani, nowy → nowi.
+var virileAlternations = []struct {
+	hard, virile string
+}{
+	{"st", "ści"}, // prosty → prości
+	{"sł", "śli"}, // dorosły → dorośli
+	{"sn", "śni"}, // jasny → jaśni
+	{"ch", "si"},  // cichy → cisi
+	{"sz", "si"},  // lepszy → lepsi
+	{"cz", "czy"}, // proroczy → proroczy
+	{"k", "cy"},   // wielki → wielcy
+	{"g", "dzy"},  // drogi → drodzy
+	{"r", "rzy"},  // dobry → dobrzy
+	{"c", "cy"},   // czytający → czytający
+	{"t", "ci"},   // wzięty → wzięci
+	{"dz", "dzy"}, // cudzy → cudzy
+	{"d", "dzi"},  // młody → młodzi
+	{"ł", "li"},   // mały → mali
+	{"ż", "zi"},   // duży → duzi
+}
+
+// virileStem returns the virile nominative plural of a hard or velar
+// adjective stem: czytan → czytani, wzięt → wzięci, wielk → wielcy.
+func virileStem(stem string) string {
+	for _, alt := range virileAlternations {
+		if rest, ok := strings.CutSuffix(stem, alt.hard); ok {
+			return rest + alt.virile
+		}
+	}
+	return stem + "i"
+}
@@ -0,0 +1,64 @@
+package verb
+
+import "testing"
+
+func TestDeclineParticiple(t *testing.T) {
+	got := DeclineParticiple("czytany")
+	want := ParticipleDeclension{
+		Nom: AdjectiveCase{SgM: "czytany", SgF: "czytana", SgN: "czytane", PlV: "czytani", PlNV: "czytane"},
+		Gen: AdjectiveCase{SgM: "czytanego", SgF: "czytanej", SgN: "czytanego", PlV: "czytanych", PlNV: "czytanych"},
+		Dat: AdjectiveCase{SgM: "czytanemu", SgF: "czytanej", SgN: "czytanemu", PlV: "czytanym", PlNV: "czytanym"},
+		Acc: AdjectiveCase{SgM: "czytanego", SgMInan: "czytany", SgF: "czytaną", SgN: "czytane", PlV: "czytanych", PlNV: "czytane"},
+		Ins: AdjectiveCase{SgM: "czytanym", SgF: "czytaną", SgN: "czytanym", PlV: "czytanymi", PlNV: "czytanymi"},
+		Loc: AdjectiveCase{SgM: "czytanym", SgF: "czytanej", SgN: "czytanym", PlV: "czytanych", PlNV: "czytanych"},
+		Voc: AdjectiveCase{SgM: "czytany", SgF: "czytana", SgN: "czytane", PlV: "czytani", PlNV: "czytane"},
+	}
+	if got != want {
+		t.Errorf("DeclineParticiple(czytany) =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestDeclineParticipleForms(t *testing.T) {
+	tests := []struct {
+		base                            string
+		nomPlV, nomPlNV, genSgM, insSgF string
+	}{
+		{"czytany", "czytani", "czytane", "czytanego", "czytaną"},
+		{"noszony", "noszeni", "noszone", "noszonego", "noszoną"}, // -ony → -eni
+		{"niesiony", "niesieni", "niesione", "niesionego", "niesioną"},
+		{"wzięty", "wzięci", "wzięte", "wziętego", "wziętą"},
+		{"otwarty", "otwarci", "otwarte", "otwartego", "otwartą"},
+		{"widziany", "widziani", "widziane", "widzianego", "widzianą"},
+		{"czytający", "czytający", "czytające", "czytającego", "czytającą"},
+		{"śmiejący się", "śmiejący się", "śmiejące się", "śmiejącego się", "śmiejącą się"},
+
+		// The shared engine also declines plain adjectives
+		{"wielki", "wielcy", "wielkie", "wielkiego", "wielką"},
+		{"drogi", "drodzy", "drogie", "drogiego", "drogą"},
+		{"tani", "tani", "tanie", "taniego", "tanią"},
+		{"dobry", "dobrzy", "dobre", "dobrego", "dobrą"},
+		{"cichy", "cisi", "ciche", "cichego", "cichą"},
+		{"młody", "młodzi", "młode", "młodego", "młodą"},
+		{"prosty", "prości", "proste", "prostego", "prostą"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.base, func(t *testing.T) {
+			d := DeclineParticiple(tt.base)
+			got := []string{d.Nom.PlV, d.Nom.PlNV, d.Gen.SgM, d.Ins.SgF}
+			want := []string{tt.nomPlV, tt.nomPlNV, tt.genSgM, tt.insSgF}
+			for i := range want {
+				if got[i] != want[i] {
+					t.Errorf("DeclineParticiple(%q) = %v, want %v", tt.base, got, want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestDeclineParticipleNotAdjective(t *testing.T) {
+	if d := DeclineParticiple("czytać"); d != (ParticipleDeclension{}) {
+		t.Errorf("DeclineParticiple(czytać) = %+v, want empty", d)
+	}
+}
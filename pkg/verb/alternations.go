@@ -0,0 +1,79 @@
+package verb
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Vowel alternations shared by the past tense builders. Each is a pure
+// string transform that changes at most one vowel, the rightmost one it
+// applies to, so a prefix never alternates: wy+sechł, za+miótł.
+
+// ApplyEToA turns the rightmost ę into ą or e into a, whichever comes
+// last: więdnę → wiądnę (zwiądł), bledn → bladn (bladł). A stem with
+// neither is returned unchanged.
+func ApplyEToA(stem string) string {
+	runes := []rune(stem)
+	for i := len(runes) - 1; i >= 0; i-- {
+		switch runes[i] {
+		case 'ę':
+			runes[i] = 'ą'
+			return string(runes)
+		case 'e':
+			runes[i] = 'a'
+			return string(runes)
+		}
+	}
+	return stem
+}
+
+// ApplyAToE is the reverse of ApplyEToA: it turns the rightmost ą into ę
+// or a into e, whichever comes last: kopną → kopnę (kopnęła), trząs →
+// trzęs (trzęsła). A stem with neither is returned unchanged.
+func ApplyAToE(stem string) string {
+	runes := []rune(stem)
+	for i := len(runes) - 1; i >= 0; i-- {
+		switch runes[i] {
+		case 'ą':
+			runes[i] = 'ę'
+			return string(runes)
+		case 'a':
+			runes[i] = 'e'
+			return string(runes)
+		}
+	}
+	return stem
+}
+
+// ApplyOToOKreska turns the rightmost o into ó, the lengthening of the
+// sg3m before a voiced or final consonant: mog → móg (mógł), niosł →
+// niósł. A stem without o is returned unchanged.
+func ApplyOToOKreska(stem string) string {
+	runes := []rune(stem)
+	for i := len(runes) - 1; i >= 0; i-- {
+		if runes[i] == 'o' {
+			runes[i] = 'ó'
+			return string(runes)
+		}
+	}
+	return stem
+}
+
+// InsertEpenthetic inserts a fleeting e before the final consonant of a
+// stem ending in a consonant cluster, reading the digraphs ch, cz, dz, rz
+// and sz as one consonant: sch → sech (sechł). A stem of one consonant is
+// returned unchanged.
+func InsertEpenthetic(stem string) string {
+	_, size := utf8.DecodeLastRuneInString(stem)
+	last := len(stem) - size
+	for _, digraph := range []string{"ch", "cz", "dz", "rz", "sz"} {
+		if strings.HasSuffix(stem, digraph) {
+			last = len(stem) - len(digraph)
+			break
+		}
+	}
+	if last <= 0 {
+		return stem
+	}
+	return stem[:last] + "e" + stem[last:]
+}
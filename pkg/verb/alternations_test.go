@@ -0,0 +1,107 @@
+package verb
+
+import "testing"
+
+func TestApplyEToA(t *testing.T) {
+	tests := map[string]string{
+		"więdn":  "wiądn",
+		"bledn":  "bladn",
+		"zwiędn": "zwiądn",
+		"przeę":  "przeą", // rightmost wins
+		"kwit":   "kwit",
+		"":       "",
+	}
+	for in, want := range tests {
+		if got := ApplyEToA(in); got != want {
+			t.Errorf("ApplyEToA(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestApplyAToE(t *testing.T) {
+	tests := map[string]string{
+		"kopną":   "kopnę",
+		"ciągną":  "ciągnę",
+		"trząs":   "trzęs",
+		"wytrząś": "wytrzęś",
+		"jad":     "jed",
+		"kwit":    "kwit",
+		"":        "",
+	}
+	for in, want := range tests {
+		if got := ApplyAToE(in); got != want {
+			t.Errorf("ApplyAToE(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestApplyOToOKreska(t *testing.T) {
+	tests := map[string]string{
+		"mog":     "móg",
+		"pomog":   "pomóg",
+		"mok":     "mók",
+		"odniosł": "odniósł", // the prefix keeps its o
+		"wlek":    "wlek",
+		"":        "",
+	}
+	for in, want := range tests {
+		if got := ApplyOToOKreska(in); got != want {
+			t.Errorf("ApplyOToOKreska(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestInsertEpenthetic(t *testing.T) {
+	tests := map[string]string{
+		"sch":   "sech",
+		"wysch": "wysech",
+		"mś":    "meś",
+		"s":     "s",
+		"":      "",
+	}
+	for in, want := range tests {
+		if got := InsertEpenthetic(in); got != want {
+			t.Errorf("InsertEpenthetic(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+// TestAlternatingPastForms pins the past forms of the builders that use
+// the alternations: ó in sg3m only, fleeting e, ę→ą, ą→ę.
+func TestAlternatingPastForms(t *testing.T) {
+	tests := []struct {
+		infinitive, sg1m, sg3m, sg3f, pl3v string
+	}{
+		{"zamieść", "zamiotłem", "zamiótł", "zamiotła", "zamietli"},
+		{"ugnieść", "ugniotłem", "ugniótł", "ugniotła", "ugnietli"},
+		{"przywieść", "przywiodłem", "przywiódł", "przywiodła", "przywiedli"},
+		{"nieść", "niosłem", "niósł", "niosła", "nieśli"},
+		{"wieźć", "wiozłem", "wiózł", "wiozła", "wieźli"},
+		{"pomóc", "pomogłem", "pomógł", "pomogła", "pomogli"},
+		{"strzec", "strzegłem", "strzegł", "strzegła", "strzegli"},
+		{"moknąć", "mokłem", "mókł", "mokła", "mokli"},
+		{"zmoknąć", "zmokłem", "zmókł", "zmokła", "zmokli"},
+		{"schnąć", "schłem", "sechł", "schła", "schli"},
+		{"wyschnąć", "wyschłem", "wysechł", "wyschła", "wyschli"},
+		{"zwiędnąć", "zwiądłem", "zwiądł", "zwiędła", "zwiędli"},
+		{"kwitnąć", "kwitnąłem", "kwitł", "kwitnęła", "kwitli"},
+		{"cuchnąć", "cuchnąłem", "cuchł", "cuchnęła", "cuchli"},
+		{"ciągnąć", "ciągnąłem", "ciągnął", "ciągnęła", "ciągnęli"},
+		{"kopnąć", "kopnąłem", "kopnął", "kopnęła", "kopnęli"},
+		{"trząść", "trząsłem", "trząsł", "trzęsła", "trzęśli"},
+		{"prząść", "prządłem", "prządł", "przędła", "przędli"},
+	}
+	for _, tt := range tests {
+		paradigms, err := ConjugatePast(tt.infinitive)
+		if err != nil {
+			t.Errorf("ConjugatePast(%q): %v", tt.infinitive, err)
+			continue
+		}
+		p := paradigms[0]
+		got := [4]string{p.Sg1M, p.Sg3M, p.Sg3F, p.Pl3V}
+		want := [4]string{tt.sg1m, tt.sg3m, tt.sg3f, tt.pl3v}
+		if got != want {
+			t.Errorf("ConjugatePast(%q) sg1m/sg3m/sg3f/pl3v = %v, want %v", tt.infinitive, got, want)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package verb
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Analysis is one reading of an inflected verb form: the infinitive it
+// belongs to and the slot it fills. Gender is zero for present forms;
+// Gloss tells homograph readings apart.
+type Analysis struct {
+	Infinitive string
+	Tense      Tense
+	Person     Person
+	Number     Number
+	Gender     Gender
+	Gloss      string
+}
+
+// Analyze returns every reading of an inflected form: piszę → pisać
+// present 1sg, czytały → czytać past 3pl non-masculine-personal. Ambiguous
+// forms give several analyses: zmyli is the present of zmylić and the past
+// of zmyć. Reflexive forms are analyzed without się and reported with a
+// reflexive infinitive: boję się → bać się.
+//
+// Forms of lexicon verbs are found in a reverse index built on first use.
+// Other forms are analyzed by reconstructing plausible infinitives from
+// their endings and keeping those whose conjugation reproduces the form,
+// so every analysis returned is one the package would generate. The
+// heuristics accept almost any stem, so reconstructions are tried in
+// order of preference and only the first that fits is returned:
+//  1. a prefixed lexicon verb: przebiegają → przebiegać
+//  2. the most regular infinitive for the ending: fascynują → fascynować
+func Analyze(form string) ([]Analysis, error) {
+	if base, ok := splitReflexive(form); ok {
+		analyses, err := Analyze(base)
+		if err != nil {
+			return nil, fmt.Errorf("no analysis for %q", form)
+		}
+		for i := range analyses {
+			analyses[i].Infinitive += reflexiveParticle
+		}
+		return analyses, nil
+	}
+
+	if analyses := analysisIndex()[form]; len(analyses) > 0 {
+		return slices.Clone(analyses), nil
+	}
+
+	groups := reconstructInfinitives(form)
+	var known []string
+	for _, group := range groups {
+		for _, infinitive := range group {
+			if hasLexiconBase(infinitive) {
+				known = append(known, infinitive)
+			}
+		}
+	}
+	for _, group := range append([][]string{known}, groups...) {
+		var analyses []Analysis
+		for _, infinitive := range group {
+			analyses = append(analyses, analyzeAs(form, infinitive)...)
+		}
+		if len(analyses) > 0 {
+			return analyses, nil
+		}
+	}
+	return nil, fmt.Errorf("no analysis for %q", form)
+}
+
+// hasLexiconBase reports whether a verb is a lexicon verb with one or more
+// prefixes: przebiegać (prze + biegać).
+func hasLexiconBase(infinitive string) bool {
+	for _, p := range verbPrefixes {
+		rest, ok := strings.CutPrefix(infinitive, p)
+		if !ok || rest == "" {
+			continue
+		}
+		if _, ok := lexiconIndex()[rest]; ok || hasLexiconBase(rest) {
+			return true
+		}
+	}
+	return false
+}
+
+// analysisIndex maps every present and past form of the lexicon verbs to
+// its analyses.
+var analysisIndex = sync.OnceValue(func() map[string][]Analysis {
+	index := make(map[string][]Analysis)
+	for _, e := range lexicon() {
+		for _, a := range formAnalyses(e.infinitive) {
+			index[a.form] = append(index[a.form], a.Analysis)
+		}
+	}
+	return index
+})
+
+// formAnalysis pairs an analysis with the form it describes.
+type formAnalysis struct {
+	Analysis
+	form string
+}
+
+// formAnalyses conjugates a verb in every tense and returns each form with its
+// analysis. Tenses the verb cannot be conjugated in are skipped.
+func formAnalyses(infinitive string) []formAnalysis {
+	var out []formAnalysis
+	add := func(t Table, gloss string) {
+		for _, row := range t.Rows {
+			for _, form := range row.Forms {
+				out = append(out, formAnalysis{
+					Analysis: Analysis{
+						Infinitive: infinitive,
+						Tense:      t.Tense,
+						Person:     row.Person,
+						Number:     row.Number,
+						Gender:     row.Gender,
+						Gloss:      gloss,
+					},
+					form: form,
+				})
+			}
+		}
+	}
+	if present, err := ConjugatePresent(infinitive); err == nil {
+		for _, p := range present {
+			add(p.PresentTense.Table(AbbrevLabels), p.Gloss)
+		}
+	}
+	if past, err := ConjugatePast(infinitive); err == nil {
+		for _, p := range past {
+			add(p.PastTense.Table(AbbrevLabels), p.Gloss)
+		}
+	}
+	return out
+}
+
+// analyzeAs returns the analyses of form as a form of infinitive.
+func analyzeAs(form, infinitive string) []Analysis {
+	var out []Analysis
+	for _, a := range formAnalyses(infinitive) {
+		if a.form == form {
+			out = append(out, a.Analysis)
+		}
+	}
+	return out
+}
+
+// pastEndings are the past tense person/gender endings after the
+// l-participle stem, longest first.
+var pastEndings = []string{
+	"liśmy", "łyśmy", "liście", "łyście",
+	"łem", "łam", "łeś", "łaś", "li", "ły", "ła", "ło", "ł",
+}
+
+// presentEndings are the present tense endings, longest first.
+var presentEndings = []string{
+	"ecie", "icie", "ycie", "acie", "emy", "imy", "ymy", "amy",
+	"esz", "isz", "ysz", "asz", "cie", "my", "sz",
+	"ę", "e", "i", "y", "ą", "a", "m",
+}
+
+// reconstructInfinitives guesses the infinitives a form could belong to
+// from its ending, as groups in order of preference. The guesses are
+// deliberately loose; Analyze keeps only those that conjugate back to the
+// form.
+func reconstructInfinitives(form string) [][]string {
+	var past, pastEc, presentOwac, presentJ, present []string
+	for _, ending := range pastEndings {
+		stem, ok := strings.CutSuffix(form, ending)
+		if !ok || stem == "" {
+			continue
+		}
+		past = append(past, stem+"ć") // czyta-ł, wzią-ł, widzie-li
+		if s, ok := strings.CutSuffix(stem, "ia"); ok {
+			pastEc = append(pastEc, s+"ieć") // widzia-ł
+		} else if s, ok := strings.CutSuffix(stem, "a"); ok {
+			pastEc = append(pastEc, s+"eć") // słysza-ł
+		} else if s, ok := strings.CutSuffix(stem, "ę"); ok {
+			pastEc = append(pastEc, s+"ąć") // wzię-li
+		}
+	}
+	for _, ending := range presentEndings {
+		stem, ok := strings.CutSuffix(form, ending)
+		if !ok || stem == "" {
+			continue
+		}
+		if s, ok := strings.CutSuffix(stem, "uj"); ok {
+			presentOwac = append(presentOwac, s+"ować") // kupuj-ę
+		}
+		if s, ok := strings.CutSuffix(stem, "j"); ok {
+			presentJ = append(presentJ, s+"ć") // czytaj-ą, pij-ę
+		}
+		present = append(present, stem+"ć") // czyta-m
+		for _, suffix := range []string{"ać", "ić", "yć", "eć", "ieć"} {
+			present = append(present, stem+suffix)
+		}
+	}
+	return [][]string{past, pastEc, presentOwac, presentJ, present}
+}
@@ -0,0 +1,52 @@
+package verb
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		form string
+		want []Analysis
+	}{
+		{"piszę", []Analysis{{Infinitive: "pisać", Tense: Present, Person: First, Number: Singular}}},
+		{"czytały", []Analysis{{Infinitive: "czytać", Tense: Past, Person: Third, Number: Plural, Gender: NonMascPersonal}}},
+		{"poszedł", []Analysis{{Infinitive: "pójść", Tense: Past, Person: Third, Number: Singular, Gender: Masculine}}},
+		{"stoję", []Analysis{{Infinitive: "stać", Tense: Present, Person: First, Number: Singular, Gloss: "to stand"}}},
+		{"boję się", []Analysis{{Infinitive: "bać się", Tense: Present, Person: First, Number: Singular}}},
+		{"zmyli", []Analysis{
+			{Infinitive: "zmylić", Tense: Present, Person: Third, Number: Singular},
+			{Infinitive: "zmyć", Tense: Past, Person: Third, Number: Plural, Gender: MascPersonal},
+		}},
+
+		// Outside the lexicon: reconstructed infinitives
+		{"przebiegają", []Analysis{{Infinitive: "przebiegać", Tense: Present, Person: Third, Number: Plural}}},
+		{"fascynują", []Analysis{{Infinitive: "fascynować", Tense: Present, Person: Third, Number: Plural}}},
+		{"czytywałem", []Analysis{{Infinitive: "czytywać", Tense: Past, Person: First, Number: Singular, Gender: Masculine}}},
+		{"podpisujemy", []Analysis{{Infinitive: "podpisywać", Tense: Present, Person: First, Number: Plural}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.form, func(t *testing.T) {
+			got, err := Analyze(tt.form)
+			if err != nil {
+				t.Fatalf("Analyze(%q) error: %v", tt.form, err)
+			}
+			for _, want := range tt.want {
+				if !slices.Contains(got, want) {
+					t.Errorf("Analyze(%q) = %v, missing %v", tt.form, got, want)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("Analyze(%q) = %v, want %d analyses", tt.form, got, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestAnalyzeNoMatch(t *testing.T) {
+	if got, err := Analyze("xyz"); err == nil {
+		t.Errorf("Analyze(xyz) = %v, want error", got)
+	}
+}
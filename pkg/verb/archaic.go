@@ -0,0 +1,55 @@
+package verb
+
+import "strings"
+
+// archaicAcVerbs maps the archaic -ąc and -ęc infinitives to the modern
+// -nąć verbs they shorten, whose present they share: przysiąc is
+// przysięgnąć, zaprząc is zaprzęgnąć. Their past is the n-less one
+// throughout (see archaicAcPast). Entries match as suffixes, so
+// prefixed forms (zaprząc, krzywoprzysiąc) follow their base; where two
+// match the longest wins, so dosiąc is dosięgnąć but przesiąc is
+// przesiąknąć. Other -ąc and -ęc infinitives are not conjugated.
+var archaicAcVerbs = map[string]string{
+	"siąc":     "sięgnąć",
+	"przesiąc": "przesiąknąć",
+	"prząc":    "przęgnąć",
+	"ląc":      "lęgnąć",
+	"przeląc":  "przelęknąć",
+	"zląc":     "zlęknąć",
+	"lęc":      "lęknąć",
+}
+
+// isArchaicAcShape reports whether an infinitive ends in -ąc or -ęc, the
+// shape of the shortened infinitives in archaicAcVerbs.
+func isArchaicAcShape(infinitive string) bool {
+	return strings.HasSuffix(infinitive, "ąc") || strings.HasSuffix(infinitive, "ęc")
+}
+
+// modernAcEquivalent returns the modern -nąć verb an archaic -ąc or -ęc
+// infinitive shortens: zaprząc → zaprzęgnąć.
+func modernAcEquivalent(infinitive string) (string, bool) {
+	var archaic string
+	for a := range archaicAcVerbs {
+		if strings.HasSuffix(infinitive, a) && len(a) > len(archaic) {
+			archaic = a
+		}
+	}
+	if archaic == "" {
+		return "", false
+	}
+	return strings.TrimSuffix(infinitive, archaic) + archaicAcVerbs[archaic], true
+}
+
+// archaicAcPast builds the past of an archaic -ąc or -ęc infinitive from
+// its modern verb's stem without -nąć: przesiąc has no -n- in any form
+// (przesiąkłem, przesiąkła), where przesiąknąć keeps it in some. The
+// masculine singular takes the modern verb's ę → ą alternation: przysiągł,
+// przysięgła.
+func archaicAcPast(infinitive string) (PastTense, bool) {
+	modern, ok := modernAcEquivalent(infinitive)
+	if !ok {
+		return PastTense{}, false
+	}
+	stem := strings.TrimSuffix(modern, "nąć")
+	return buildPastTenseWithAlternation(applyMascSgAlternation(stem, modern), stem), true
+}
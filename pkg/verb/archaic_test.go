@@ -0,0 +1,63 @@
+package verb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestArchaicAcInfinitives(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		modern     string
+		wantSg1    string
+		wantSg3M   string
+		wantSg3F   string
+		wantVerbal string
+	}{
+		{"przysiąc", "przysięgnąć", "przysięgnę", "przysiągł", "przysięgła", "przysięgnięcie"},
+		{"zaprząc", "zaprzęgnąć", "zaprzęgnę", "zaprzągł", "zaprzęgła", "zaprzęgnięcie"},
+		{"przesiąc", "przesiąknąć", "przesiąknę", "przesiąkł", "przesiąkła", "przesiąknięcie"},
+		{"ląc", "lęgnąć", "lęgnę", "lągł", "lęgła", "lęgnięcie"},
+		{"lęc", "lęknąć", "lęknę", "ląkł", "lękła", "lęknięcie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			present, err := ConjugatePresentExplained(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresentExplained(%q) error: %v", tt.infinitive, err)
+			}
+			if present[0].Sg1 != tt.wantSg1 || present[0].Source != "archaic:"+tt.modern {
+				t.Errorf("ConjugatePresentExplained(%q) = %s (%s), want %s (archaic:%s)",
+					tt.infinitive, present[0].Sg1, present[0].Source, tt.wantSg1, tt.modern)
+			}
+
+			past, err := ConjugatePast(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePast(%q) error: %v", tt.infinitive, err)
+			}
+			if p := past[0]; p.Sg3M != tt.wantSg3M || p.Sg3F != tt.wantSg3F {
+				t.Errorf("ConjugatePast(%q) = %s, %s; want %s, %s", tt.infinitive, p.Sg3M, p.Sg3F, tt.wantSg3M, tt.wantSg3F)
+			}
+
+			verbal, err := VerbalNoun(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q) error: %v", tt.infinitive, err)
+			}
+			if verbal[0] != tt.wantVerbal {
+				t.Errorf("VerbalNoun(%q) = %v, want %s first", tt.infinitive, verbal, tt.wantVerbal)
+			}
+		})
+	}
+
+	// An -ąc infinitive with no modern equivalent fails in all three
+	for name, call := range map[string]func(string) error{
+		"ConjugatePresent": func(s string) error { _, err := ConjugatePresent(s); return err },
+		"ConjugatePast":    func(s string) error { _, err := ConjugatePast(s); return err },
+		"VerbalNoun":       func(s string) error { _, err := VerbalNoun(s); return err },
+	} {
+		if err := call("bząc"); !errors.Is(err, ErrNoMatch) {
+			t.Errorf("%s(bząc) error = %v, want ErrNoMatch", name, err)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package verb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AspectClass is the grammatical aspect of a verb.
+type AspectClass int
+
+const (
+	Imperfective AspectClass = iota + 1 // czytać, pisać
+	Perfective                          // przeczytać, napisać
+	Biaspectual                         // aresztować, ofiarować
+)
+
+// String returns the Polimorf tag for the aspect: imperf, perf, or biasp.
+func (a AspectClass) String() string {
+	switch a {
+	case Imperfective:
+		return "imperf"
+	case Perfective:
+		return "perf"
+	case Biaspectual:
+		return "biasp"
+	default:
+		return "unknown"
+	}
+}
+
+// parseAspectTag parses a Polimorf-style aspect tag (imperf, perf, biasp).
+func parseAspectTag(tag string) AspectClass {
+	switch tag {
+	case "imperf":
+		return Imperfective
+	case "perf":
+		return Perfective
+	case "biasp":
+		return Biaspectual
+	default:
+		return 0
+	}
+}
+
+// imperfectivaTantum lists verbs that exist only in the imperfective aspect:
+// they have no perfective partner, so their future is always compound
+// (będę mógł, będę musiał) and no perfective forms should be sought.
+var imperfectivaTantum = map[string]bool{
+	"móc": true, "musieć": true, "mieć": true, "umieć": true,
+	"woleć": true, "wiedzieć": true, "należeć": true, "istnieć": true,
+	"kosztować": true, "posiadać": true, "znaczyć": true, "brzmieć": true,
+	"zależeć": true, "pachnieć": true,
+}
+
+// perfectivaTantum lists verbs that exist only in the perfective aspect:
+// they have no imperfective partner, so their non-past forms (oniemieję)
+// are always future and there is no compound future.
+var perfectivaTantum = map[string]bool{
+	"oniemieć": true, "osłupieć": true, "ocknąć": true, "runąć": true,
+	"zemdleć": true, "wzdrygnąć": true, "polec": true, "zachorować": true,
+	"rozpłakać": true, "zaniemówić": true, "zasłabnąć": true,
+}
+
+// biaspectualVerbs lists common verbs that are both imperfective and
+// perfective. The corpora tag these imperf, so the frequency lexicon alone
+// cannot tell them apart.
+var biaspectualVerbs = map[string]bool{
+	"aresztować": true, "ofiarować": true, "kazać": true, "anulować": true,
+	"awansować": true, "abdykować": true, "internować": true, "deportować": true,
+	"mianować": true, "darować": true, "ewakuować": true,
+}
+
+// aspectOverrides fixes the aspect of verbs the heuristics in Aspect or
+// the frequency lexicon get wrong: lexicalized prefixes that do not make a
+// verb perfective, unprefixed perfectives the lexicon tags imperf, and
+// simplex imperfectives the lexicon lacks, whose prefixed forms are then
+// found perfective through them (zemleć, zagrzmieć).
+var aspectOverrides = map[string]AspectClass{
+	"rozumieć":   Imperfective, // roz- is part of the root
+	"wykupować":  Imperfective, // secondary imperfective of wykupić
+	"dać":        Perfective,   // suppletive pair dać/dawać
+	"stać się":   Perfective,   // stać się/stawać się, not stać
+	"wziąć":      Perfective,   // suppletive pair wziąć/brać
+	"powiedzieć": Perfective,   // suppletive pair powiedzieć/mówić
+
+	// tagged imperf in the lexicon
+	"kupić":  Perfective, // kupić/kupować
+	"stawić": Perfective, // stawić się/stawiać się
+
+	// missing from the lexicon
+	"mleć": Imperfective, "grzmieć": Imperfective, "dnieć": Imperfective,
+	"pleć": Imperfective, "żąć": Imperfective, "siec": Imperfective,
+	"wlec": Imperfective, "strzyc": Imperfective, "rwać": Imperfective,
+	"tkać": Imperfective, "dąć": Imperfective, "miąć": Imperfective,
+	"kłuć": Imperfective, "pruć": Imperfective, "snuć": Imperfective,
+	"kląć": Imperfective, "bóść": Imperfective,
+}
+
+// secondaryImperfectiveSuffixes mark derived imperfectives: przepisywać,
+// zatrzymywać, wypisywać, dostawać.
+var secondaryImperfectiveSuffixes = []string{"ywać", "iwać", "awać"}
+
+// indeterminateMotionVerbs stay imperfective under a prefix: przychodzić,
+// wynosić, odwozić (the perfectives are przyjść, wynieść, odwieźć).
+var indeterminateMotionVerbs = []string{
+	"chodzić", "nosić", "wozić", "wodzić", "jeżdżać", "biegać",
+}
+
+// Aspect classifies a verb as Imperfective, Perfective or Biaspectual.
+//
+// Known verbs are looked up in the override table, the biaspectual and
+// tantum lists and the frequency lexicon, in that order. Other verbs are
+// classified by form:
+//   - -ywać/-iwać/-awać mark secondary imperfectives: przepisywać
+//   - prefixed indeterminate verbs of motion are imperfective: wychodzić
+//   - a prefix on an imperfective base makes it perfective: napisać
+//   - an unprefixed -ować verb is imperfective: budować
+//
+// An error is returned when none of these apply.
+func Aspect(infinitive string) (AspectClass, error) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	if a := verbAspect(infinitive); a != 0 {
+		return a, nil
+	}
+
+	base, _ := splitReflexive(infinitive)
+	for _, suffix := range secondaryImperfectiveSuffixes {
+		if strings.HasSuffix(base, suffix) {
+			return Imperfective, nil
+		}
+	}
+	for _, motion := range indeterminateMotionVerbs {
+		if strings.HasSuffix(base, motion) {
+			return Imperfective, nil
+		}
+	}
+	for _, prefix := range verbPrefixes {
+		stem, ok := strings.CutPrefix(base, prefix)
+		if !ok || stem == "" {
+			continue
+		}
+		if a, err := Aspect(stem); err == nil && a == Imperfective {
+			return Perfective, nil
+		}
+	}
+	if strings.HasSuffix(base, "ować") {
+		return Imperfective, nil
+	}
+	return 0, fmt.Errorf("cannot determine aspect of %q", infinitive)
+}
+
+// verbAspect returns the known aspect of a verb from the curated lists and
+// the frequency lexicon, or zero when unknown. The curated lists take
+// precedence over the lexicon.
+func verbAspect(infinitive string) AspectClass {
+	if a, ok := aspectOverrides[infinitive]; ok {
+		return a
+	}
+	base, _ := splitReflexive(infinitive)
+	switch {
+	case biaspectualVerbs[base]:
+		return Biaspectual
+	case imperfectivaTantum[base]:
+		return Imperfective
+	case perfectivaTantum[base]:
+		return Perfective
+	}
+	return lexiconIndex()[base].aspect
+}
+
+// IsImperfectivaTantum reports whether a verb exists only in the
+// imperfective aspect (móc, musieć). Reflexive verbs are checked by
+// their base.
+func IsImperfectivaTantum(infinitive string) bool {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	base, _ := splitReflexive(infinitive)
+	return imperfectivaTantum[base]
+}
+
+// IsPerfectivaTantum reports whether a verb exists only in the perfective
+// aspect (oniemieć, ocknąć się). Reflexive verbs are checked by their base.
+func IsPerfectivaTantum(infinitive string) bool {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	base, _ := splitReflexive(infinitive)
+	return perfectivaTantum[base]
+}
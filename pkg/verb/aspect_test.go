@@ -0,0 +1,189 @@
+package verb
+
+import "testing"
+
+func TestAspectTantum(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantImperf bool
+		wantPerf   bool
+	}{
+		{"móc", true, false},
+		{"musieć", true, false},
+		{"oniemieć", false, true},
+		{"ocknąć się", false, true},
+		{"czytać", false, false},
+		{"przeczytać", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			if got := IsImperfectivaTantum(tt.infinitive); got != tt.wantImperf {
+				t.Errorf("IsImperfectivaTantum(%q) = %v, want %v", tt.infinitive, got, tt.wantImperf)
+			}
+			if got := IsPerfectivaTantum(tt.infinitive); got != tt.wantPerf {
+				t.Errorf("IsPerfectivaTantum(%q) = %v, want %v", tt.infinitive, got, tt.wantPerf)
+			}
+		})
+	}
+}
+
+func TestAspect(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       AspectClass
+	}{
+		{"pisać", Imperfective},
+		{"napisać", Perfective},
+		{"kazać", Biaspectual},
+		{"aresztować", Biaspectual},
+		{"rozumieć", Imperfective}, // lexicalized roz-
+		{"zrozumieć", Perfective},
+		{"dać", Perfective},
+		{"stać się", Perfective},
+		{"móc", Imperfective},
+		{"oniemieć", Perfective},
+
+		// Heuristics for verbs outside the lexicon
+		{"wyczytywać", Imperfective}, // secondary imperfective
+		{"podpisywać", Imperfective},
+		{"przebiegać", Imperfective}, // prefixed verb of motion
+		{"poprzychodzić", Imperfective},
+		{"wyczytać", Perfective}, // prefix + imperfective base
+		{"przeczytać się", Perfective},
+		{"zafascynować", Perfective},
+		{"fascynować", Imperfective}, // unprefixed -ować
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := Aspect(tt.infinitive)
+			if err != nil {
+				t.Fatalf("Aspect(%q) error: %v", tt.infinitive, err)
+			}
+			if got != tt.want {
+				t.Errorf("Aspect(%q) = %v, want %v", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Aspect("tlić"); err == nil {
+		t.Error("Aspect(tlić) should fail: no lexicon entry and no rule applies")
+	}
+}
+
+// TestAspectFrequentPerfectives checks high-frequency perfectives end to
+// end: the lexicon tags some of them imperf (kupić), which would give
+// them an analytic future and present participles.
+func TestAspectFrequentPerfectives(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		future     string // Sg1 of the synthetic future
+	}{
+		{"kupić", "kupię"},
+		{"dać", "dam"},
+		{"zrobić", "zrobię"},
+		{"wrócić", "wrócę"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			if a, err := Aspect(tt.infinitive); err != nil || a != Perfective {
+				t.Errorf("Aspect(%q) = %v, %v; want perf", tt.infinitive, a, err)
+			}
+			paradigms, err := ConjugateFuture(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugateFuture(%q) error: %v", tt.infinitive, err)
+			}
+			if len(paradigms) != 1 || paradigms[0].Analytic() || paradigms[0].Sg1 != tt.future {
+				t.Errorf("ConjugateFuture(%q) = %+v, want only the synthetic %s", tt.infinitive, paradigms, tt.future)
+			}
+			if got, err := ContemporaryAdverbial(tt.infinitive); err == nil {
+				t.Errorf("ContemporaryAdverbial(%q) = %q, want an error", tt.infinitive, got)
+			}
+			if got, err := ActiveParticiple(tt.infinitive); err == nil {
+				t.Errorf("ActiveParticiple(%q) = %q, want an error", tt.infinitive, got)
+			}
+		})
+	}
+}
+
+func TestAspectSimplexOutsideLexicon(t *testing.T) {
+	for _, tt := range []struct {
+		infinitive string
+		want       AspectClass
+	}{
+		{"mleć", Imperfective},
+		{"grzmieć", Imperfective},
+		{"dnieć", Imperfective},
+		{"zemleć", Perfective},
+		{"zagrzmieć", Perfective},
+	} {
+		if got, err := Aspect(tt.infinitive); err != nil || got != tt.want {
+			t.Errorf("Aspect(%q) = %v, %v; want %v", tt.infinitive, got, err, tt.want)
+		}
+	}
+
+	paradigms, err := ConjugateFuture("grzmieć")
+	if err != nil {
+		t.Fatalf("ConjugateFuture(grzmieć) error: %v", err)
+	}
+	if len(paradigms) != 1 || !paradigms[0].Analytic() {
+		t.Errorf("ConjugateFuture(grzmieć) = %+v, want only the analytic future", paradigms)
+	}
+}
+
+// TestSuppletivePairBracWziac ties the suppletive pair brać/wziąć and its
+// prefixed zabierać/zabrać together: the aspect picks the future and the
+// participles each member takes.
+func TestSuppletivePairBracWziac(t *testing.T) {
+	for _, tt := range []struct {
+		imperfective, perfective string
+		future                   string // synthetic future of the perfective
+		active                   string // active participle of the imperfective
+		anterior                 string // anterior adverbial of the perfective
+	}{
+		{"brać", "wziąć", "wezmę", "biorący", "wziąwszy"},
+		{"zabierać", "zabrać", "zabiorę", "zabierający", "zabrawszy"},
+	} {
+		t.Run(tt.perfective, func(t *testing.T) {
+			if got, err := SecondaryImperfective(tt.perfective); err != nil || got != tt.imperfective {
+				t.Errorf("SecondaryImperfective(%q) = %q, %v; want %s", tt.perfective, got, err, tt.imperfective)
+			}
+			if got, err := Aspect(tt.imperfective); err != nil || got != Imperfective {
+				t.Errorf("Aspect(%q) = %v, %v; want Imperfective", tt.imperfective, got, err)
+			}
+			if got, err := Aspect(tt.perfective); err != nil || got != Perfective {
+				t.Errorf("Aspect(%q) = %v, %v; want Perfective", tt.perfective, got, err)
+			}
+
+			paradigms, err := ConjugateFuture(tt.imperfective)
+			if err != nil {
+				t.Fatalf("ConjugateFuture(%q) error: %v", tt.imperfective, err)
+			}
+			if want := "będę " + tt.imperfective; len(paradigms) != 1 || !paradigms[0].Analytic() || paradigms[0].Sg1 != want {
+				t.Errorf("ConjugateFuture(%q) = %+v, want only the analytic %s", tt.imperfective, paradigms, want)
+			}
+			paradigms, err = ConjugateFuture(tt.perfective)
+			if err != nil {
+				t.Fatalf("ConjugateFuture(%q) error: %v", tt.perfective, err)
+			}
+			if len(paradigms) != 1 || paradigms[0].Analytic() || paradigms[0].Sg1 != tt.future {
+				t.Errorf("ConjugateFuture(%q) = %+v, want only the synthetic %s", tt.perfective, paradigms, tt.future)
+			}
+
+			if got, err := ActiveParticiple(tt.imperfective); err != nil || got != tt.active {
+				t.Errorf("ActiveParticiple(%q) = %q, %v; want %s", tt.imperfective, got, err, tt.active)
+			}
+			if got, err := ActiveParticiple(tt.perfective); err == nil {
+				t.Errorf("ActiveParticiple(%q) = %q, want an error", tt.perfective, got)
+			}
+			if got, err := AnteriorAdverbial(tt.perfective); err != nil || got != tt.anterior {
+				t.Errorf("AnteriorAdverbial(%q) = %q, %v; want %s", tt.perfective, got, err, tt.anterior)
+			}
+			if got, err := AnteriorAdverbial(tt.imperfective); err == nil {
+				t.Errorf("AnteriorAdverbial(%q) = %q, want an error", tt.imperfective, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package verb
+
+// BycForm is a special construction built on być, with its meaning.
+type BycForm struct {
+	Form  string // e.g. "nie ma"
+	Gloss string // e.g. "there is no"
+}
+
+// BycForms returns the być constructions that learners meet outside the
+// regular paradigm (jestem, byłem):
+//   - nie ma: existential negation, "there is no". It takes the genitive and
+//     borrows the 3sg of mieć; *nie jest is not used in this sense.
+//   - bywa: habitual "is (from time to time)", the 3sg of the frequentative
+//     bywać rather than a form of być itself.
+//   - nie będzie: negated future, "there will be no". Like nie ma it takes
+//     the genitive.
+//
+// These are semantically distinct from the regular forms and are not part
+// of any paradigm returned by ConjugatePresent or ConjugatePast.
+func BycForms() []BycForm {
+	// Both always conjugate: mieć is an irregular entry, bywać a regular -ać verb.
+	ma, _, _ := ThirdPerson("mieć", Present)
+	bywa, _, _ := ThirdPerson("bywać", Present)
+	bedzie := buildBedPresent("").Sg3
+	return []BycForm{
+		{Form: "nie " + ma, Gloss: "there is no"},
+		{Form: bywa, Gloss: "is (habitually)"},
+		{Form: "nie " + bedzie, Gloss: "there will be no"},
+	}
+}
@@ -0,0 +1,20 @@
+package verb
+
+import "testing"
+
+func TestBycForms(t *testing.T) {
+	want := []string{"nie ma", "bywa", "nie będzie"}
+
+	got := BycForms()
+	if len(got) != len(want) {
+		t.Fatalf("BycForms() returned %d forms, want %d", len(got), len(want))
+	}
+	for i, f := range got {
+		if f.Form != want[i] {
+			t.Errorf("BycForms()[%d].Form = %q, want %q", i, f.Form, want[i])
+		}
+		if f.Gloss == "" {
+			t.Errorf("BycForms()[%d] (%s) has no gloss", i, f.Form)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package verb
+
+// ConditionalParadigm is a conditional mood paradigm with optional gloss.
+// It has the same 13 gender/number slots as the past tense:
+// Sg1M czytałbym, Sg1F czytałabym, ... Pl3NV czytałyby.
+type ConditionalParadigm struct {
+	PastTense
+	Gloss string `json:"gloss,omitempty"`
+}
+
+// ConjugateConditional returns the conditional mood (tryb przypuszczający)
+// of a verb: the l-participle of the past tense plus the particle by and
+// the person endings (czytałbym, czytałabyś, czytaliby). It is built on
+// ConjugatePast, so suppletive stems come for free (szedłbym, mógłbym) and
+// past homographs (paść) give one paradigm each.
+func ConjugateConditional(infinitive string) ([]ConditionalParadigm, error) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	if base, ok := splitReflexive(infinitive); ok {
+		paradigms, err := ConjugateConditional(base)
+		if err != nil {
+			return nil, err
+		}
+		for i := range paradigms {
+			paradigms[i].PastTense = paradigms[i].withReflexive()
+		}
+		return paradigms, nil
+	}
+
+	past, err := ConjugatePast(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	paradigms := make([]ConditionalParadigm, len(past))
+	for i, p := range past {
+		paradigms[i] = ConditionalParadigm{PastTense: buildConditional(p.PastTense), Gloss: p.Gloss}
+	}
+	return paradigms, nil
+}
+
+// buildConditional attaches by and the person endings to the third person
+// past forms, which are the bare l-participle: czytał → czytałbym.
+func buildConditional(p PastTense) PastTense {
+	return PastTense{
+		Sg1M: p.Sg3M + "bym", Sg1F: p.Sg3F + "bym",
+		Sg2M: p.Sg3M + "byś", Sg2F: p.Sg3F + "byś",
+		Sg3M: p.Sg3M + "by", Sg3F: p.Sg3F + "by", Sg3N: p.Sg3N + "by",
+		Pl1V: p.Pl3V + "byśmy", Pl1NV: p.Pl3NV + "byśmy",
+		Pl2V: p.Pl3V + "byście", Pl2NV: p.Pl3NV + "byście",
+		Pl3V: p.Pl3V + "by", Pl3NV: p.Pl3NV + "by",
+	}
+}
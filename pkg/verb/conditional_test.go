@@ -0,0 +1,68 @@
+package verb
+
+import "testing"
+
+func TestConjugateConditional(t *testing.T) {
+	paradigms, err := ConjugateConditional("czytać")
+	if err != nil {
+		t.Fatalf("ConjugateConditional error: %v", err)
+	}
+	want := PastTense{
+		Sg1M: "czytałbym", Sg1F: "czytałabym",
+		Sg2M: "czytałbyś", Sg2F: "czytałabyś",
+		Sg3M: "czytałby", Sg3F: "czytałaby", Sg3N: "czytałoby",
+		Pl1V: "czytalibyśmy", Pl1NV: "czytałybyśmy",
+		Pl2V: "czytalibyście", Pl2NV: "czytałybyście",
+		Pl3V: "czytaliby", Pl3NV: "czytałyby",
+	}
+	if len(paradigms) != 1 || !paradigms[0].Equals(want) {
+		t.Errorf("ConjugateConditional(czytać) = %+v, want %+v", paradigms, want)
+	}
+}
+
+func TestConjugateConditionalForms(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1M   string
+		wantSg1F   string
+		wantPl3V   string
+	}{
+		{"iść", "szedłbym", "szłabym", "szliby"}, // suppletive past stem
+		{"móc", "mógłbym", "mogłabym", "mogliby"},
+		{"być", "byłbym", "byłabym", "byliby"},
+		{"wziąć", "wziąłbym", "wzięłabym", "wzięliby"},
+		{"bać się", "bałbym się", "bałabym się", "baliby się"}, // reflexive
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			paradigms, err := ConjugateConditional(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugateConditional(%q) error: %v", tt.infinitive, err)
+			}
+			p := paradigms[0]
+			if p.Sg1M != tt.wantSg1M || p.Sg1F != tt.wantSg1F || p.Pl3V != tt.wantPl3V {
+				t.Errorf("ConjugateConditional(%q) = %s, %s, %s; want %s, %s, %s",
+					tt.infinitive, p.Sg1M, p.Sg1F, p.Pl3V, tt.wantSg1M, tt.wantSg1F, tt.wantPl3V)
+			}
+		})
+	}
+}
+
+func TestConjugateConditionalHomograph(t *testing.T) {
+	paradigms, err := ConjugateConditional("paść")
+	if err != nil {
+		t.Fatalf("ConjugateConditional error: %v", err)
+	}
+	if len(paradigms) != 2 {
+		t.Fatalf("ConjugateConditional(paść) returned %d paradigms, want 2", len(paradigms))
+	}
+	for i, want := range []string{"padłby", "pasłby"} {
+		if paradigms[i].Sg3M != want {
+			t.Errorf("paradigm %d Sg3M = %s, want %s", i, paradigms[i].Sg3M, want)
+		}
+		if paradigms[i].Gloss == "" {
+			t.Errorf("paradigm %d lost its gloss", i)
+		}
+	}
+}
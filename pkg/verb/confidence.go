@@ -0,0 +1,65 @@
+package verb
+
+import "fmt"
+
+// Confidence is how reliable a paradigm is, judged by the rule that
+// produced it. The zero value is High, so paradigms built outside the
+// conjugation functions (tables, literals) count as reliable.
+type Confidence int
+
+const (
+	High   Confidence = iota // irregular or homograph table entry
+	Medium                   // suffix-specific heuristic: -ować, -nąć, -ść...
+	Low                      // catch-all -ać/-eć fallback
+)
+
+// String returns high, medium or low.
+func (c Confidence) String() string {
+	switch c {
+	case High:
+		return "high"
+	case Medium:
+		return "medium"
+	case Low:
+		return "low"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText encodes the confidence as its name, so JSON output reads
+// "confidence": "low".
+func (c Confidence) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes a confidence name written by MarshalText.
+func (c *Confidence) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "high":
+		*c = High
+	case "medium":
+		*c = Medium
+	case "low":
+		*c = Low
+	default:
+		return fmt.Errorf("unknown confidence: %q", text)
+	}
+	return nil
+}
+
+// fallbackHeuristics are the catch-all heuristics tried last, which accept
+// any verb with the right ending and so are the least reliable.
+var fallbackHeuristics = map[string]bool{
+	"heuristicAc": true, "heuristicEc": true,
+	"heuristicPastAc": true, "heuristicPastEc": true,
+}
+
+// heuristicConfidence returns the confidence of a paradigm produced by the
+// named heuristic.
+func heuristicConfidence(name string) Confidence {
+	if fallbackHeuristics[name] {
+		return Low
+	}
+	return Medium
+}
@@ -0,0 +1,36 @@
+package verb
+
+import "testing"
+
+func TestConfidence(t *testing.T) {
+	tests := []struct {
+		infinitive  string
+		wantPresent Confidence
+		wantPast    Confidence
+	}{
+		{"być", High, High},       // irregular
+		{"stać", High, High},      // homograph
+		{"zjeść", High, High},     // prefixed irregular
+		{"robić", Medium, Medium}, // -ić heuristic
+		{"przyjść", Medium, Medium},
+		{"czytać", Low, Low},   // -ać fallback
+		{"bać się", High, Low}, // irregular present, regular bał
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			present, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			past, err := ConjugatePast(tt.infinitive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if present[0].Confidence != tt.wantPresent || past[0].Confidence != tt.wantPast {
+				t.Errorf("%s: confidence = %v/%v, want %v/%v", tt.infinitive,
+					present[0].Confidence, past[0].Confidence, tt.wantPresent, tt.wantPast)
+			}
+		})
+	}
+}
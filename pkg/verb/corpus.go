@@ -0,0 +1,117 @@
+package verb
+
+import (
+	"bufio"
+	"compress/gzip"
+	"embed"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// corpusData holds the embedded gold-standard corpus, written by
+// cmd/gendata from testdata/verbs.json, or from the hand-checked
+// testdata/verbs_present_seed.json where that corpus is unavailable (see
+// data/corpus/README). present.tsv.gz is optional: a tree built without
+// it embeds only the README.
+//
+//go:embed data/corpus
+var corpusData embed.FS
+
+// presentCorpus parses data/corpus/present.tsv.gz on first use: one
+// "infinitive<TAB>sg1<TAB>...<TAB>pl3" line per paradigm, grouped by
+// infinitive in corpus order. It is empty when the file is absent.
+var presentCorpus = sync.OnceValue(func() map[string][]PresentTense {
+	corpus := make(map[string][]PresentTense)
+	f, err := corpusData.Open("data/corpus/present.tsv.gz")
+	if err != nil {
+		return corpus
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return corpus
+	}
+	scanner := bufio.NewScanner(zr)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) != 7 {
+			continue
+		}
+		corpus[fields[0]] = append(corpus[fields[0]], PresentTense{
+			Sg1: fields[1], Sg2: fields[2], Sg3: fields[3],
+			Pl1: fields[4], Pl2: fields[5], Pl3: fields[6],
+		})
+	}
+	return corpus
+})
+
+// Lookup returns the corpus paradigm of a verb, bypassing the heuristics:
+// the forms are attested rather than derived. For homographs it returns
+// the first corpus paradigm; ConjugatePresentTrusted returns them all.
+// Reports false for verbs the corpus lacks, reflexive infinitives
+// included, and for every verb when the corpus is not embedded.
+func Lookup(infinitive string) (*PresentTense, bool) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	paradigms := presentCorpus()[infinitive]
+	if len(paradigms) == 0 {
+		return nil, false
+	}
+	pt := paradigms[0]
+	return &pt, true
+}
+
+// ConjugatePresentTrusted is ConjugatePresent that prefers the embedded
+// corpus: attested paradigms when the verb (or the base of a reflexive
+// verb) is in it, otherwise the heuristic engine. Corpus paradigms carry
+// High confidence and no gloss.
+func ConjugatePresentTrusted(infinitive string) ([]Paradigm, error) {
+	norm, capital := normalizeInfinitive(infinitive)
+	if err := checkInfinitive(norm); err != nil {
+		return nil, err
+	}
+	base, reflexive := splitReflexive(norm)
+	attested := presentCorpus()[base]
+	if len(attested) == 0 {
+		return ConjugatePresent(infinitive)
+	}
+	paradigms := make([]Paradigm, 0, len(attested))
+	for _, pt := range attested {
+		if reflexive {
+			pt = pt.withReflexive()
+		}
+		if capital {
+			pt = pt.capitalized()
+		}
+		paradigms = appendParadigm(paradigms, Paradigm{
+			PresentTense: pt,
+			Defective:    defectiveSlots(norm, Present),
+		})
+	}
+	return paradigms, nil
+}
+
+// ErrHeuristicOnly is returned by ConjugatePresentStrict for verbs whose
+// paradigm would come from a general heuristic rather than the corpus or
+// an irregular table.
+var ErrHeuristicOnly = errors.New("paradigm is only backed by a heuristic")
+
+// ConjugatePresentStrict is ConjugatePresentTrusted for output that must
+// not be guessed, such as printed teaching materials: it returns only
+// paradigms attested in the embedded corpus or taken from the irregular
+// and homograph tables (High confidence), and an error wrapping
+// ErrHeuristicOnly otherwise, so czytać fails when the corpus is not
+// embedded. Callers can send those verbs for review.
+func ConjugatePresentStrict(infinitive string) ([]Paradigm, error) {
+	paradigms, err := ConjugatePresentTrusted(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range paradigms {
+		if p.Confidence != High {
+			return nil, fmt.Errorf("%q: %w", infinitive, ErrHeuristicOnly)
+		}
+	}
+	return paradigms, nil
+}
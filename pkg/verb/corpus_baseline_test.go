@@ -0,0 +1,184 @@
+package verb
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"slices"
+	"testing"
+)
+
+// baselineFile records the accuracy of each corpus and the infinitives it
+// fails, so TestCorpusBaseline can catch regressions.
+const baselineFile = "testdata/baseline.json"
+
+// updateBaselineEnv, when set, makes TestCorpusBaseline rewrite
+// baselineFile with the current scores instead of checking them:
+//
+//	ODMIANY_UPDATE_BASELINE=1 go test ./pkg/verb -run TestCorpusBaseline
+const updateBaselineEnv = "ODMIANY_UPDATE_BASELINE"
+
+// baselineEpsilon is the accuracy drop, in percentage points, tolerated
+// before TestCorpusBaseline fails.
+const baselineEpsilon = 0.01
+
+// corpusScore is the result of checking every infinitive of a corpus.
+type corpusScore struct {
+	Accuracy float64  `json:"accuracy"` // percent
+	Total    int      `json:"total"`
+	Failures []string `json:"failures"` // sorted
+}
+
+// presentSeedFile is the checked-in present corpus the embedded
+// present.tsv.gz is built from. The full verbs.json is generated and not
+// checked in, so the present baseline is kept against the seed.
+const presentSeedFile = "testdata/verbs_present_seed.json"
+
+// corpusScorers lists the corpora TestCorpusBaseline checks, keyed by the
+// name used in baselineFile. Every file is checked in.
+var corpusScorers = []struct {
+	name  string
+	score func(t testing.TB) corpusScore
+}{
+	{"present", scorePresentCorpus},
+	{"past", scorePastCorpus},
+	{"verbalNoun", scoreVerbalNounCorpus},
+}
+
+// TestCorpusBaseline fails when a corpus scores below its baseline
+// accuracy and logs the infinitives that newly fail.
+func TestCorpusBaseline(t *testing.T) {
+	baseline := make(map[string]corpusScore)
+	data, err := os.ReadFile(baselineFile)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &baseline); err != nil {
+			t.Fatalf("failed to parse %s: %v", baselineFile, err)
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		t.Fatalf("failed to load %s: %v", baselineFile, err)
+	}
+	update := os.Getenv(updateBaselineEnv) != ""
+
+	for _, c := range corpusScorers {
+		t.Run(c.name, func(t *testing.T) {
+			got := c.score(t)
+			t.Logf("%s corpus accuracy: %.2f%% (%d failures of %d)",
+				c.name, got.Accuracy, len(got.Failures), got.Total)
+			if update {
+				baseline[c.name] = got
+				return
+			}
+
+			want, ok := baseline[c.name]
+			if !ok {
+				t.Fatalf("no %s baseline in %s; set %s=1 to record it", c.name, baselineFile, updateBaselineEnv)
+			}
+			var newFailures []string
+			for _, inf := range got.Failures {
+				if _, found := slices.BinarySearch(want.Failures, inf); !found {
+					newFailures = append(newFailures, inf)
+				}
+			}
+			for i, inf := range newFailures {
+				if i == 50 {
+					t.Logf("... and %d more", len(newFailures)-i)
+					break
+				}
+				t.Logf("newly failing: %s", inf)
+			}
+			if got.Accuracy < want.Accuracy-baselineEpsilon {
+				t.Errorf("%s accuracy %.2f%% below baseline %.2f%%", c.name, got.Accuracy, want.Accuracy)
+			}
+		})
+	}
+
+	if update {
+		data, err := json.MarshalIndent(baseline, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(baselineFile, append(data, '\n'), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// newCorpusScore builds a score from the failing infinitives of a corpus.
+func newCorpusScore(total int, failures []string) corpusScore {
+	if failures == nil {
+		failures = []string{} // [] rather than null in baselineFile
+	}
+	slices.Sort(failures)
+	return corpusScore{
+		Accuracy: float64(total-len(failures)) / float64(total) * 100,
+		Total:    total,
+		Failures: failures,
+	}
+}
+
+// scorePresentCorpus checks that some present paradigm of each seed
+// corpus infinitive matches one of its corpus paradigms.
+func scorePresentCorpus(t testing.TB) corpusScore {
+	byInfinitive := make(map[string][]PresentTense)
+	for _, e := range loadPresentCorpusFile(t, presentSeedFile) {
+		byInfinitive[e.Infinitive] = append(byInfinitive[e.Infinitive], PresentTense{
+			Sg1: e.Sg1, Sg2: e.Sg2, Sg3: e.Sg3,
+			Pl1: e.Pl1, Pl2: e.Pl2, Pl3: e.Pl3,
+		})
+	}
+	var failures []string
+	for inf, want := range byInfinitive {
+		paradigms, _ := ConjugatePresent(inf)
+		if !slices.ContainsFunc(paradigms, func(p Paradigm) bool {
+			return slices.ContainsFunc(want, p.PresentTense.Equals)
+		}) {
+			failures = append(failures, inf)
+		}
+	}
+	return newCorpusScore(len(byInfinitive), failures)
+}
+
+// scorePastCorpus checks that some past paradigm of each corpus
+// infinitive matches one of its corpus paradigms.
+func scorePastCorpus(t testing.TB) corpusScore {
+	byInfinitive := make(map[string][]PastTense)
+	for _, e := range loadPastCorpus(t) {
+		byInfinitive[e.Infinitive] = append(byInfinitive[e.Infinitive], PastTense{
+			Sg1M: e.Sg1M, Sg1F: e.Sg1F,
+			Sg2M: e.Sg2M, Sg2F: e.Sg2F,
+			Sg3M: e.Sg3M, Sg3F: e.Sg3F, Sg3N: e.Sg3N,
+			Pl1V: e.Pl1V, Pl1NV: e.Pl1NV,
+			Pl2V: e.Pl2V, Pl2NV: e.Pl2NV,
+			Pl3V: e.Pl3V, Pl3NV: e.Pl3NV,
+		})
+	}
+	var failures []string
+	for inf, want := range byInfinitive {
+		paradigms, _ := ConjugatePast(inf)
+		if !slices.ContainsFunc(paradigms, func(p PastParadigm) bool {
+			return slices.ContainsFunc(want, p.PastTense.Equals)
+		}) {
+			failures = append(failures, inf)
+		}
+	}
+	return newCorpusScore(len(byInfinitive), failures)
+}
+
+// scoreVerbalNounCorpus checks that each corpus infinitive yields one of
+// its corpus verbal nouns.
+func scoreVerbalNounCorpus(t testing.TB) corpusScore {
+	byInfinitive := make(map[string][]string)
+	for _, e := range loadVerbalNounCorpus(t) {
+		byInfinitive[e.Infinitive] = append(byInfinitive[e.Infinitive], e.VerbalNoun)
+	}
+	var failures []string
+	for inf, want := range byInfinitive {
+		forms, _ := VerbalNoun(inf)
+		if !slices.ContainsFunc(forms, func(f string) bool { return slices.Contains(want, f) }) {
+			failures = append(failures, inf)
+		}
+	}
+	return newCorpusScore(len(byInfinitive), failures)
+}
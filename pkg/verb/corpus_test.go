@@ -2,6 +2,7 @@ package verb
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"sort"
@@ -38,9 +39,16 @@ type pastCorpusEntry struct {
 	Aspect     string `json:"aspect"`
 }
 
-func loadCorpus(t *testing.T) []corpusEntry {
+func loadCorpus(t testing.TB) []corpusEntry {
 	t.Helper()
-	data, err := os.ReadFile("testdata/verbs.json")
+	return loadPresentCorpusFile(t, "testdata/verbs.json")
+}
+
+// loadPresentCorpusFile loads a present corpus in the verbs.json format,
+// such as the checked-in testdata/verbs_present_seed.json.
+func loadPresentCorpusFile(t testing.TB, file string) []corpusEntry {
+	t.Helper()
+	data, err := os.ReadFile(file)
 	if err != nil {
 		t.Fatalf("failed to load corpus: %v", err)
 	}
@@ -51,7 +59,7 @@ func loadCorpus(t *testing.T) []corpusEntry {
 	return entries
 }
 
-func loadPastCorpus(t *testing.T) []pastCorpusEntry {
+func loadPastCorpus(t testing.TB) []pastCorpusEntry {
 	t.Helper()
 	data, err := os.ReadFile("testdata/verbs_past.json")
 	if err != nil {
@@ -139,11 +147,7 @@ func TestCorpusAccuracy(t *testing.T) {
 		t.Logf("  %4d: %s", p.count, p.pattern)
 	}
 
-	// For now, don't fail the test - we're iterating on heuristics
-	// Uncomment this when we want to enforce a threshold:
-	// if accuracy < 95.0 {
-	// 	t.Errorf("accuracy %.2f%% below threshold 95%%", accuracy)
-	// }
+	// TestCorpusBaseline fails on regressions; this test only reports.
 }
 
 // classifyFailure returns a pattern string for grouping similar failures.
@@ -160,15 +164,12 @@ func classifyFailure(infinitive, errorType string) string {
 func describeError(infinitive string, expected, got PresentTense) string {
 	var diffs []string
 
-	if expected.Sg1 != got.Sg1 {
+	for _, d := range expected.Diff(got) {
+		if d.Slot.Number != Singular {
+			continue
+		}
 		// Extract the ending pattern
-		diffs = append(diffs, fmt.Sprintf("1sg: want %s got %s", ending(expected.Sg1), ending(got.Sg1)))
-	}
-	if expected.Sg2 != got.Sg2 {
-		diffs = append(diffs, fmt.Sprintf("2sg: want %s got %s", ending(expected.Sg2), ending(got.Sg2)))
-	}
-	if expected.Sg3 != got.Sg3 {
-		diffs = append(diffs, fmt.Sprintf("3sg: want %s got %s", ending(expected.Sg3), ending(got.Sg3)))
+		diffs = append(diffs, fmt.Sprintf("%s: want %s got %s", d.Slot, ending(d.Want), ending(d.Got)))
 	}
 
 	if len(diffs) > 2 {
@@ -322,7 +323,7 @@ type verbalNounCorpusEntry struct {
 	VerbalNoun string `json:"verbal_noun"`
 }
 
-func loadVerbalNounCorpus(t *testing.T) []verbalNounCorpusEntry {
+func loadVerbalNounCorpus(t testing.TB) []verbalNounCorpusEntry {
 	t.Helper()
 	data, err := os.ReadFile("testdata/verbs_verbal_noun.json")
 	if err != nil {
@@ -408,18 +409,111 @@ func TestCorpusVerbalNounAccuracy(t *testing.T) {
 	}
 }
 
+type imperativeCorpusEntry struct {
+	Infinitive string `json:"infinitive"`
+	Sg2        string `json:"sg2"`
+	Pl1        string `json:"pl1"`
+	Pl2        string `json:"pl2"`
+	Aspect     string `json:"aspect"`
+}
+
+// loadImperativeCorpus loads testdata/verbs_imperative.json, skipping the
+// test in trees where cmd/gencorpora has not written it yet.
+func loadImperativeCorpus(t testing.TB) []imperativeCorpusEntry {
+	t.Helper()
+	data, err := os.ReadFile("testdata/verbs_imperative.json")
+	if errors.Is(err, os.ErrNotExist) {
+		t.Skip("imperative corpus not generated; run cmd/gencorpora")
+	}
+	if err != nil {
+		t.Fatalf("failed to load imperative corpus: %v", err)
+	}
+	var entries []imperativeCorpusEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		t.Fatalf("failed to parse imperative corpus: %v", err)
+	}
+	return entries
+}
+
+func TestCorpusImperativeAccuracy(t *testing.T) {
+	entries := loadImperativeCorpus(t)
+
+	// Group corpus paradigms by infinitive (some verbs have 2sg variants)
+	byInfinitive := make(map[string][]ImperativeParadigm)
+	for _, e := range entries {
+		byInfinitive[e.Infinitive] = append(byInfinitive[e.Infinitive], ImperativeParadigm{Sg2: e.Sg2, Pl1: e.Pl1, Pl2: e.Pl2})
+	}
+
+	var passed, failed, noMatch int
+	failures := make(map[string]int)
+
+	for infinitive, corpusParadigms := range byInfinitive {
+		predicted, err := Imperative(infinitive)
+		if err != nil {
+			noMatch++
+			failures[classifyFailure(infinitive, "no_match")]++
+			continue
+		}
+
+		// Check if ANY predicted paradigm matches a corpus paradigm
+		anyMatch := false
+		for _, want := range corpusParadigms {
+			for _, got := range predicted {
+				if got.Sg2 == want.Sg2 && got.Pl1 == want.Pl1 && got.Pl2 == want.Pl2 {
+					anyMatch = true
+					break
+				}
+			}
+			if anyMatch {
+				break
+			}
+		}
+
+		if anyMatch {
+			passed++
+		} else {
+			failed++
+			desc := fmt.Sprintf("want %s got %s", corpusParadigms[0].Sg2, predicted[0].Sg2)
+			failures[classifyFailure(infinitive, desc)]++
+		}
+	}
+
+	total := len(byInfinitive)
+	accuracy := float64(passed) / float64(total) * 100
+
+	t.Logf("Imperative corpus accuracy: %.2f%% (%d/%d passed, %d failed, %d no match)",
+		accuracy, passed, total, failed, noMatch)
+
+	type failurePattern struct {
+		pattern string
+		count   int
+	}
+	var patterns []failurePattern
+	for p, c := range failures {
+		patterns = append(patterns, failurePattern{p, c})
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		return patterns[i].count > patterns[j].count
+	})
+
+	t.Log("\nTop failure patterns:")
+	for i, p := range patterns {
+		if i >= 20 {
+			break
+		}
+		t.Logf("  %4d: %s", p.count, p.pattern)
+	}
+}
+
 // describePastError returns a short description of how the past conjugation differs.
 func describePastError(infinitive string, expected, got PastTense) string {
 	var diffs []string
 
-	if expected.Sg3M != got.Sg3M {
-		diffs = append(diffs, fmt.Sprintf("3sgM: want %s got %s", ending(expected.Sg3M), ending(got.Sg3M)))
-	}
-	if expected.Sg3F != got.Sg3F {
-		diffs = append(diffs, fmt.Sprintf("3sgF: want %s got %s", ending(expected.Sg3F), ending(got.Sg3F)))
-	}
-	if expected.Pl3V != got.Pl3V {
-		diffs = append(diffs, fmt.Sprintf("3plV: want %s got %s", ending(expected.Pl3V), ending(got.Pl3V)))
+	for _, d := range expected.Diff(got) {
+		switch d.Slot {
+		case Slot{Third, Singular, Masculine}, Slot{Third, Singular, Feminine}, Slot{Third, Plural, MascPersonal}:
+			diffs = append(diffs, fmt.Sprintf("%s: want %s got %s", d.Slot, ending(d.Want), ending(d.Got)))
+		}
 	}
 
 	if len(diffs) > 2 {
@@ -485,3 +579,159 @@ func TestSamplePastVerbs(t *testing.T) {
 		}
 	}
 }
+
+// withPresentCorpus replaces the embedded corpus for the duration of a
+// test.
+func withPresentCorpus(t *testing.T, corpus map[string][]PresentTense) {
+	t.Helper()
+	saved := presentCorpus
+	t.Cleanup(func() { presentCorpus = saved })
+	presentCorpus = func() map[string][]PresentTense { return corpus }
+}
+
+func TestLookup(t *testing.T) {
+	// A deliberately archaic paradigm, which the heuristics would not give
+	attested := PresentTense{Sg1: "szeptam", Sg2: "szeptasz", Sg3: "szepta", Pl1: "szeptamy", Pl2: "szeptacie", Pl3: "szeptają"}
+	withPresentCorpus(t, map[string][]PresentTense{"szeptać": {attested}})
+
+	got, ok := Lookup("szeptać")
+	if !ok || *got != attested {
+		t.Errorf("Lookup(szeptać) = %v, %v; want %+v, true", got, ok, attested)
+	}
+	if _, ok := Lookup("czytać"); ok {
+		t.Error("Lookup(czytać) found a verb missing from the corpus")
+	}
+
+	// Corpus hits bypass the heuristics, misses fall back to them
+	trusted, err := ConjugatePresentTrusted("szeptać")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trusted) != 1 || trusted[0].PresentTense != attested || trusted[0].Confidence != High {
+		t.Errorf("ConjugatePresentTrusted(szeptać) = %+v, want the corpus paradigm", trusted)
+	}
+	heuristic, err := ConjugatePresent("szeptać")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if heuristic[0].PresentTense == attested {
+		t.Fatal("heuristics produce the corpus paradigm, so the test proves nothing")
+	}
+
+	trusted, err = ConjugatePresentTrusted("szeptać się")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trusted[0].Sg1 != "szeptam się" {
+		t.Errorf("ConjugatePresentTrusted(szeptać się).Sg1 = %q, want szeptam się", trusted[0].Sg1)
+	}
+
+	fallback, err := ConjugatePresentTrusted("czytać")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fallback[0].Sg1 != "czytam" {
+		t.Errorf("ConjugatePresentTrusted(czytać).Sg1 = %q, want czytam", fallback[0].Sg1)
+	}
+}
+
+func TestConjugatePresentStrict(t *testing.T) {
+	czytam := PresentTense{Sg1: "czytam", Sg2: "czytasz", Sg3: "czyta", Pl1: "czytamy", Pl2: "czytacie", Pl3: "czytają"}
+	withPresentCorpus(t, map[string][]PresentTense{"czytać": {czytam}})
+
+	// Attested in the corpus, and by the heuristics too
+	got, err := ConjugatePresentStrict("czytać")
+	if err != nil {
+		t.Fatalf("ConjugatePresentStrict(czytać) error: %v", err)
+	}
+	if len(got) != 1 || got[0].PresentTense != czytam {
+		t.Errorf("ConjugatePresentStrict(czytać) = %+v, want the corpus paradigm", got)
+	}
+
+	// Irregular entries, prefixed or not, need no corpus
+	for _, infinitive := range []string{"być", "zabrać", "stać"} {
+		if _, err := ConjugatePresentStrict(infinitive); err != nil {
+			t.Errorf("ConjugatePresentStrict(%q) error: %v", infinitive, err)
+		}
+	}
+
+	// Regular verbs missing from the corpus only have a heuristic answer
+	for _, infinitive := range []string{"dziergać", "lajkować", "lajkować się"} {
+		if _, err := ConjugatePresentStrict(infinitive); !errors.Is(err, ErrHeuristicOnly) {
+			t.Errorf("ConjugatePresentStrict(%q) error = %v, want ErrHeuristicOnly", infinitive, err)
+		}
+		if _, err := ConjugatePresent(infinitive); err != nil {
+			t.Errorf("ConjugatePresent(%q) error: %v", infinitive, err)
+		}
+	}
+
+	if _, err := ConjugatePresentStrict("pies"); !errors.Is(err, ErrNotInfinitive) {
+		t.Errorf("ConjugatePresentStrict(pies) error = %v, want ErrNotInfinitive", err)
+	}
+}
+
+func TestConjugatePresentStrictEmbedded(t *testing.T) {
+	// No stubbed corpus: these are served by the embedded one
+	for infinitive, sg1 := range map[string]string{"czytać": "czytam", "móc": "mogę", "Czytać": "Czytam", "czytać się": "czytam się"} {
+		got, err := ConjugatePresentStrict(infinitive)
+		if err != nil {
+			t.Errorf("ConjugatePresentStrict(%q) error: %v", infinitive, err)
+			continue
+		}
+		if got[0].Sg1 != sg1 || got[0].Confidence != High {
+			t.Errorf("ConjugatePresentStrict(%q) = %+v, want %s with High confidence", infinitive, got, sg1)
+		}
+	}
+	if _, err := ConjugatePresentStrict("dziergać"); !errors.Is(err, ErrHeuristicOnly) {
+		t.Errorf("ConjugatePresentStrict(dziergać) error = %v, want ErrHeuristicOnly", err)
+	}
+}
+
+// uniqueInfinitives returns the distinct infinitives of a corpus, in
+// sorted order, so homographs are conjugated once.
+func uniqueInfinitives[E any](entries []E, infinitive func(E) string) []string {
+	seen := make(map[string]bool, len(entries))
+	var infinitives []string
+	for _, e := range entries {
+		if inf := infinitive(e); !seen[inf] {
+			seen[inf] = true
+			infinitives = append(infinitives, inf)
+		}
+	}
+	sort.Strings(infinitives)
+	return infinitives
+}
+
+// BenchmarkConjugatePresentCorpus conjugates every present corpus
+// infinitive once per iteration.
+func BenchmarkConjugatePresentCorpus(b *testing.B) {
+	infinitives := uniqueInfinitives(loadCorpus(b), func(e corpusEntry) string { return e.Infinitive })
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, inf := range infinitives {
+			ConjugatePresent(inf)
+		}
+	}
+}
+
+// BenchmarkConjugatePastCorpus conjugates every past corpus infinitive
+// once per iteration.
+func BenchmarkConjugatePastCorpus(b *testing.B) {
+	infinitives := uniqueInfinitives(loadPastCorpus(b), func(e pastCorpusEntry) string { return e.Infinitive })
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, inf := range infinitives {
+			ConjugatePast(inf)
+		}
+	}
+}
+
+func TestEmbeddedPresentCorpus(t *testing.T) {
+	want := PresentTense{Sg1: "czytam", Sg2: "czytasz", Sg3: "czyta", Pl1: "czytamy", Pl2: "czytacie", Pl3: "czytają"}
+	if got, ok := Lookup("czytać"); !ok || *got != want {
+		t.Fatalf("Lookup(czytać) = %v, %v; want %+v from the embedded corpus", got, ok, want)
+	}
+	for _, f := range CorpusFailures(Present) {
+		t.Errorf("%s: diffs %v, error %v", f.Infinitive, f.Diffs, f.Err)
+	}
+}
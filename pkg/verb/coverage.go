@@ -0,0 +1,55 @@
+package verb
+
+import (
+	"maps"
+	"slices"
+)
+
+// IrregularPresentVerbs returns the infinitives listed in the present tense
+// tables, homographs included, sorted. Prefixed forms derived from a
+// prefixable base are not listed; IsIrregular reports those.
+func IrregularPresentVerbs() []string {
+	verbs := make(map[string]bool, len(irregularSpecs)+len(homographs))
+	for infinitive, s := range irregularSpecs {
+		if s.present != nil {
+			verbs[infinitive] = true
+		}
+	}
+	for infinitive := range homographs {
+		verbs[infinitive] = true
+	}
+	return slices.Sorted(maps.Keys(verbs))
+}
+
+// IrregularPastVerbs returns the infinitives listed in the past tense
+// tables, homographs included, sorted.
+func IrregularPastVerbs() []string {
+	verbs := make(map[string]bool, len(irregularSpecs)+len(pastHomographs))
+	for infinitive, s := range irregularSpecs {
+		if s.past != nil {
+			verbs[infinitive] = true
+		}
+	}
+	for infinitive := range pastHomographs {
+		verbs[infinitive] = true
+	}
+	return slices.Sorted(maps.Keys(verbs))
+}
+
+// IsIrregular reports which tenses of a verb come from the irregular
+// tables rather than the heuristics, either directly or through a
+// prefixable base: być → true, true, true; dostać → false, true,
+// false; czytać → false, false, false.
+func IsIrregular(infinitive string) (present, past, verbalNoun bool) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	_, present = presentIndex[infinitive]
+	if !present {
+		_, _, present = lookupHomograph(infinitive)
+	}
+	_, past = pastIndex[infinitive]
+	if !past {
+		_, past = pastHomographs[infinitive]
+	}
+	_, verbalNoun = vnIndex[infinitive]
+	return present, past, verbalNoun
+}
@@ -0,0 +1,66 @@
+package verb
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestIrregularVerbCounts pins the size of the irregular tables, so an
+// entry added or dropped by accident shows up here. Update the counts
+// when the tables change on purpose.
+func TestIrregularVerbCounts(t *testing.T) {
+	tests := []struct {
+		name  string
+		verbs []string
+		want  int
+	}{
+		{"present", IrregularPresentVerbs(), 157},
+		{"past", IrregularPastVerbs(), 150},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.verbs) != tt.want {
+				t.Errorf("%d irregular %s verbs, want %d", len(tt.verbs), tt.name, tt.want)
+			}
+			if !slices.IsSorted(tt.verbs) {
+				t.Errorf("irregular %s verbs are not sorted", tt.name)
+			}
+			if len(slices.Compact(slices.Clone(tt.verbs))) != len(tt.verbs) {
+				t.Errorf("irregular %s verbs have duplicates", tt.name)
+			}
+		})
+	}
+}
+
+func TestIrregularVerbsSnapshot(t *testing.T) {
+	verbs := IrregularPresentVerbs()
+	verbs[0] = "zmienione"
+	if IrregularPresentVerbs()[0] == "zmienione" {
+		t.Error("IrregularPresentVerbs returned a shared slice")
+	}
+}
+
+func TestIsIrregular(t *testing.T) {
+	tests := []struct {
+		infinitive        string
+		present, past, vn bool
+	}{
+		{"być", true, true, true},
+		{"iść", true, true, true},
+		{"stać", true, true, false},    // homograph
+		{"dostać", false, true, false}, // prefixed past only
+		{"wypaść", false, true, true},
+		{"czytać", false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			present, past, vn := IsIrregular(tt.infinitive)
+			if present != tt.present || past != tt.past || vn != tt.vn {
+				t.Errorf("IsIrregular(%q) = %v, %v, %v; want %v, %v, %v",
+					tt.infinitive, present, past, vn, tt.present, tt.past, tt.vn)
+			}
+		})
+	}
+}
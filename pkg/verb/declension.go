@@ -0,0 +1,81 @@
+package verb
+
+import "strings"
+
+// CaseForms holds a noun's forms in the seven Polish cases.
+type CaseForms struct {
+	Nom  string `json:"nom"`  // mianownik - czytanie
+	Gen  string `json:"gen"`  // dopełniacz - czytania
+	Dat  string `json:"dat"`  // celownik - czytaniu
+	Acc  string `json:"acc"`  // biernik - czytanie
+	Inst string `json:"inst"` // narzędnik - czytaniem
+	Loc  string `json:"loc"`  // miejscownik - czytaniu
+	Voc  string `json:"voc"`  // wołacz - czytanie
+}
+
+// VerbalNounDeclension holds the case forms of a verbal noun. Most verbal
+// nouns are used in the singular only, but the plural is regular
+// (zebrania, zebrań) and given for the ones that take it.
+type VerbalNounDeclension struct {
+	Singular CaseForms `json:"singular"`
+	Plural   CaseForms `json:"plural"`
+}
+
+// DeclineVerbalNoun declines a verbal noun as returned by VerbalNoun. All
+// verbal nouns are neuters in -nie or -cie and follow the soft neuter
+// pattern: czytanie, czytania, czytaniu; picie, picia, piciu. The genitive
+// plural drops -ie and hardens the consonant's spelling: czytań, pić. A
+// reflexive noun keeps "się" after every form: czytanie się, czytania się.
+// Input not ending in -nie or -cie returns the zero value.
+func DeclineVerbalNoun(vn string) VerbalNounDeclension {
+	base, reflexive := splitReflexive(vn)
+	var genPl string
+	switch {
+	case strings.HasSuffix(base, "nie"):
+		genPl = strings.TrimSuffix(base, "nie") + "ń"
+	case strings.HasSuffix(base, "cie"):
+		genPl = strings.TrimSuffix(base, "cie") + "ć"
+	default:
+		return VerbalNounDeclension{}
+	}
+
+	stem := strings.TrimSuffix(base, "e") // czytani-, pici-
+	d := VerbalNounDeclension{
+		Singular: CaseForms{
+			Nom:  base,
+			Gen:  stem + "a",
+			Dat:  stem + "u",
+			Acc:  base,
+			Inst: stem + "em",
+			Loc:  stem + "u",
+			Voc:  base,
+		},
+		Plural: CaseForms{
+			Nom:  stem + "a",
+			Gen:  genPl,
+			Dat:  stem + "om",
+			Acc:  stem + "a",
+			Inst: stem + "ami",
+			Loc:  stem + "ach",
+			Voc:  stem + "a",
+		},
+	}
+	if reflexive {
+		d.Singular = d.Singular.withReflexive()
+		d.Plural = d.Plural.withReflexive()
+	}
+	return d
+}
+
+// withReflexive appends "się" to every case form.
+func (c CaseForms) withReflexive() CaseForms {
+	return CaseForms{
+		Nom:  c.Nom + reflexiveParticle,
+		Gen:  c.Gen + reflexiveParticle,
+		Dat:  c.Dat + reflexiveParticle,
+		Acc:  c.Acc + reflexiveParticle,
+		Inst: c.Inst + reflexiveParticle,
+		Loc:  c.Loc + reflexiveParticle,
+		Voc:  c.Voc + reflexiveParticle,
+	}
+}
@@ -0,0 +1,43 @@
+package verb
+
+import "testing"
+
+func TestDeclineVerbalNoun(t *testing.T) {
+	tests := []struct {
+		vn   string
+		want VerbalNounDeclension
+	}{
+		{"czytanie", VerbalNounDeclension{
+			Singular: CaseForms{"czytanie", "czytania", "czytaniu", "czytanie", "czytaniem", "czytaniu", "czytanie"},
+			Plural:   CaseForms{"czytania", "czytań", "czytaniom", "czytania", "czytaniami", "czytaniach", "czytania"},
+		}},
+		{"picie", VerbalNounDeclension{
+			Singular: CaseForms{"picie", "picia", "piciu", "picie", "piciem", "piciu", "picie"},
+			Plural:   CaseForms{"picia", "pić", "piciom", "picia", "piciami", "piciach", "picia"},
+		}},
+		{"śmianie się", VerbalNounDeclension{
+			Singular: CaseForms{"śmianie się", "śmiania się", "śmianiu się", "śmianie się", "śmianiem się", "śmianiu się", "śmianie się"},
+			Plural:   CaseForms{"śmiania się", "śmiań się", "śmianiom się", "śmiania się", "śmianiami się", "śmianiach się", "śmiania się"},
+		}},
+		// not a verbal noun
+		{"pies", VerbalNounDeclension{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.vn, func(t *testing.T) {
+			if got := DeclineVerbalNoun(tt.vn); got != tt.want {
+				t.Errorf("DeclineVerbalNoun(%q) = %+v, want %+v", tt.vn, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeclineVerbalNounOfVerbalNoun(t *testing.T) {
+	vns, err := VerbalNoun("zebrać")
+	if err != nil {
+		t.Fatalf("VerbalNoun(zebrać) error: %v", err)
+	}
+	if got := DeclineVerbalNoun(vns[0]).Plural.Gen; got != "zebrań" {
+		t.Errorf("genitive plural of %s = %q, want zebrań", vns[0], got)
+	}
+}
@@ -0,0 +1,103 @@
+package verb
+
+import (
+	"fmt"
+	"slices"
+)
+
+// presentSlotNames and pastSlotNames name the slots as the JSON forms
+// keys do, in presentSlots and pastSlots order.
+var (
+	presentSlotNames = [6]string{"sg1", "sg2", "sg3", "pl1", "pl2", "pl3"}
+	pastSlotNames    = [13]string{
+		"sg1m", "sg1f", "sg2m", "sg2f", "sg3m", "sg3f", "sg3n",
+		"pl1v", "pl1nv", "pl2v", "pl2nv", "pl3v", "pl3nv",
+	}
+)
+
+// String returns the slot's forms key: sg3 for a present slot, sg3n for a
+// past one.
+func (s Slot) String() string {
+	if i := slices.Index(presentSlots[:], s); i >= 0 {
+		return presentSlotNames[i]
+	}
+	if i := slices.Index(pastSlots[:], s); i >= 0 {
+		return pastSlotNames[i]
+	}
+	return "unknown"
+}
+
+// MarshalText encodes the slot as its forms key, so JSON output reads
+// "defective": ["sg1", "sg2", ...].
+func (s Slot) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a forms key written by MarshalText.
+func (s *Slot) UnmarshalText(text []byte) error {
+	if i := slices.Index(presentSlotNames[:], string(text)); i >= 0 {
+		*s = presentSlots[i]
+		return nil
+	}
+	if i := slices.Index(pastSlotNames[:], string(text)); i >= 0 {
+		*s = pastSlots[i]
+		return nil
+	}
+	return fmt.Errorf("unknown slot: %q", text)
+}
+
+// defectivity is which persons a defective verb is used in.
+type defectivity int
+
+const (
+	impersonal      defectivity = iota + 1 // 3sg only, neuter in the past: dnieje, dniało
+	thirdPersonOnly                        // 3sg and 3pl: roi się, roją się
+)
+
+// has reports whether a verb of this defectivity has a form in slot s.
+func (d defectivity) has(s Slot) bool {
+	switch d {
+	case impersonal:
+		return s.Person == Third && s.Number == Singular && (s.Gender == 0 || s.Gender == Neuter)
+	case thirdPersonOnly:
+		return s.Person == Third
+	default:
+		return true
+	}
+}
+
+// defectiveVerbs lists verbs used in only some persons. The engine still
+// builds their full paradigms; the missing slots are listed in the
+// paradigms' Defective field.
+var defectiveVerbs = map[string]defectivity{
+	// Weather and time-of-day verbs: dnieje, grzmi, mży
+	"dnieć": impersonal, "świtać": impersonal, "zaświtać": impersonal,
+	"zmierzchać": impersonal, "zmierzchać się": impersonal,
+	"rozwidniać się": impersonal, "rozwidnić się": impersonal,
+	"grzmieć": impersonal, "mżyć": impersonal, "błyskać się": impersonal,
+
+	// Verbs whose subject is never a person: roi się, dzieje się
+	"roić się": thirdPersonOnly, "dziać się": thirdPersonOnly,
+	"zdarzać się": thirdPersonOnly, "zdarzyć się": thirdPersonOnly,
+	"wydarzać się": thirdPersonOnly, "wydarzyć się": thirdPersonOnly,
+}
+
+// defectiveSlots returns the slots of the given tense a verb has no form
+// in, or nil for a verb used in every person.
+func defectiveSlots(infinitive string, tense Tense) []Slot {
+	d, ok := defectiveVerbs[infinitive]
+	if !ok {
+		return nil
+	}
+	slots := presentSlots[:]
+	if tense == Past {
+		slots = pastSlots[:]
+	}
+	var missing []Slot
+	for _, s := range slots {
+		if !d.has(s) {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,92 @@
+package verb
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDefectivePresent(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		valid      []string // slots with a form in use
+	}{
+		{"dnieć", []string{"sg3"}},
+		{"grzmieć", []string{"sg3"}},
+		{"świtać", []string{"sg3"}},
+		{"roić się", []string{"sg3", "pl3"}},
+		{"dziać się", []string{"sg3", "pl3"}},
+		{"czytać", []string{"sg1", "sg2", "sg3", "pl1", "pl2", "pl3"}},
+		{"roić", []string{"sg1", "sg2", "sg3", "pl1", "pl2", "pl3"}}, // only roić się is defective
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			paradigms, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var valid []string
+			for _, s := range presentSlots {
+				if !slices.Contains(paradigms[0].Defective, s) {
+					valid = append(valid, s.String())
+				}
+			}
+			if !slices.Equal(valid, tt.valid) {
+				t.Errorf("%s valid slots = %v, want %v", tt.infinitive, valid, tt.valid)
+			}
+		})
+	}
+}
+
+func TestDefectivePast(t *testing.T) {
+	paradigms, err := ConjugatePast("dnieć")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(paradigms[0].Defective); got != 12 {
+		t.Errorf("dnieć past has %d defective slots, want 12", got)
+	}
+	if slices.Contains(paradigms[0].Defective, Slot{Third, Singular, Neuter}) {
+		t.Error("dnieć past marks sg3n (dniało) defective")
+	}
+
+	paradigms, err = ConjugatePast("roić się")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(paradigms[0].Defective); got != 8 {
+		t.Errorf("roić się past has %d defective slots, want 8", got)
+	}
+}
+
+func TestPresentFormDefective(t *testing.T) {
+	if got, err := PresentForm("dnieć", Third, Singular); err != nil || got != "dnieje" {
+		t.Errorf("PresentForm(dnieć, 3sg) = %q, %v; want dnieje", got, err)
+	}
+	if got, err := PresentForm("dnieć", First, Singular); err == nil {
+		t.Errorf("PresentForm(dnieć, 1sg) = %q, want an error", got)
+	}
+}
+
+func TestDefectiveJSON(t *testing.T) {
+	paradigms, err := ConjugatePresent("roić się")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(paradigms[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"defective":["sg1","sg2","pl1","pl2"]`) {
+		t.Errorf("json.Marshal(roić się) = %s, want the defective slots listed", data)
+	}
+	var back Paradigm
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(back.Defective, paradigms[0].Defective) {
+		t.Errorf("round trip Defective = %v, want %v", back.Defective, paradigms[0].Defective)
+	}
+}
@@ -0,0 +1,57 @@
+package verb
+
+// SlotDiff is one slot in which two paradigms differ: Want is the form of
+// the paradigm Diff is called on, Got the form of the one passed to it.
+type SlotDiff struct {
+	Slot Slot
+	Want string
+	Got  string
+}
+
+// Diff returns the slots in which other differs from p, in PresentTense
+// field order. It returns nil for equal paradigms.
+func (p PresentTense) Diff(other PresentTense) []SlotDiff {
+	var diffs []SlotDiff
+	for _, s := range presentSlots {
+		want, got := p.Get(s.Person, s.Number), other.Get(s.Person, s.Number)
+		if want != got {
+			diffs = append(diffs, SlotDiff{Slot: s, Want: want, Got: got})
+		}
+	}
+	return diffs
+}
+
+// Diff returns the slots in which other differs from p, in PastTense
+// field order. It returns nil for equal paradigms.
+func (p PastTense) Diff(other PastTense) []SlotDiff {
+	var diffs []SlotDiff
+	for _, s := range pastSlots {
+		want, got := p.Get(s.Person, s.Number, s.Gender), other.Get(s.Person, s.Number, s.Gender)
+		if want != got {
+			diffs = append(diffs, SlotDiff{Slot: s, Want: want, Got: got})
+		}
+	}
+	return diffs
+}
+
+// Equals reports whether two paradigms have the same forms. Gloss,
+// confidence and the other annotations are ignored.
+func (p Paradigm) Equals(other Paradigm) bool {
+	return p.PresentTense.Equals(other.PresentTense)
+}
+
+// Diff returns the slots in which other's forms differ from p's.
+func (p Paradigm) Diff(other Paradigm) []SlotDiff {
+	return p.PresentTense.Diff(other.PresentTense)
+}
+
+// Equals reports whether two past paradigms have the same forms. Gloss,
+// confidence and the other annotations are ignored.
+func (p PastParadigm) Equals(other PastParadigm) bool {
+	return p.PastTense.Equals(other.PastTense)
+}
+
+// Diff returns the slots in which other's forms differ from p's.
+func (p PastParadigm) Diff(other PastParadigm) []SlotDiff {
+	return p.PastTense.Diff(other.PastTense)
+}
@@ -0,0 +1,71 @@
+package verb
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParadigmDiff(t *testing.T) {
+	czytac := Paradigm{
+		PresentTense: PresentTense{"czytam", "czytasz", "czyta", "czytamy", "czytacie", "czytają"},
+		Gloss:        "to read",
+	}
+
+	// Gloss and confidence are not compared
+	same := czytac
+	same.Gloss, same.Confidence = "", Low
+	if !czytac.Equals(same) || czytac.Diff(same) != nil {
+		t.Errorf("paradigms differing only in gloss: Equals = %v, Diff = %v", czytac.Equals(same), czytac.Diff(same))
+	}
+
+	oneSlot := czytac
+	oneSlot.Sg3 = "czytaje"
+	want := []SlotDiff{{Slot{Third, Singular, 0}, "czyta", "czytaje"}}
+	if czytac.Equals(oneSlot) {
+		t.Error("Equals = true for paradigms differing in sg3")
+	}
+	if got := czytac.Diff(oneSlot); !slices.Equal(got, want) {
+		t.Errorf("Diff = %v, want %v", got, want)
+	}
+
+	pisac := Paradigm{PresentTense: PresentTense{"piszę", "piszesz", "pisze", "piszemy", "piszecie", "piszą"}}
+	got := czytac.Diff(pisac)
+	if len(got) != len(presentSlots) {
+		t.Fatalf("Diff of unrelated paradigms has %d slots, want %d", len(got), len(presentSlots))
+	}
+	for i, d := range got {
+		if d.Slot != presentSlots[i] || d.Want != czytac.Get(d.Slot.Person, d.Slot.Number) || d.Got != pisac.Get(d.Slot.Person, d.Slot.Number) {
+			t.Errorf("Diff[%d] = %+v", i, d)
+		}
+	}
+}
+
+func TestPastParadigmDiff(t *testing.T) {
+	past, err := ConjugatePast("czytać")
+	if err != nil {
+		t.Fatalf("ConjugatePast(czytać) error: %v", err)
+	}
+	czytal := past[0]
+
+	oneSlot := czytal
+	oneSlot.Gloss = "to read"
+	oneSlot.Pl3V = "czytałi"
+	want := []SlotDiff{{Slot{Third, Plural, MascPersonal}, "czytali", "czytałi"}}
+	if czytal.Equals(oneSlot) {
+		t.Error("Equals = true for paradigms differing in pl3v")
+	}
+	if got := czytal.Diff(oneSlot); !slices.Equal(got, want) {
+		t.Errorf("Diff = %v, want %v", got, want)
+	}
+
+	past, err = ConjugatePast("nieść")
+	if err != nil {
+		t.Fatalf("ConjugatePast(nieść) error: %v", err)
+	}
+	if got := czytal.Diff(past[0]); len(got) != len(pastSlots) {
+		t.Errorf("Diff of unrelated past paradigms has %d slots, want %d", len(got), len(pastSlots))
+	}
+	if !czytal.Equals(czytal) {
+		t.Error("Equals = false for a paradigm and itself")
+	}
+}
@@ -0,0 +1,107 @@
+package verb
+
+import (
+	"slices"
+	"strings"
+)
+
+// -eć verbs split between inchoatives, which conjugate -eję/-ejesz
+// (maleć → maleję: to become small), and action verbs, which conjugate
+// -ę/-isz or -ę/-ysz (widzieć → widzę, widzisz; leżeć → leżę, leżysz).
+// The infinitive does not tell them apart, so heuristicEcTable consults
+// the two tables below before the other -eć heuristics guess by ending.
+// Each entry matches as a suffix, so it covers the prefixed forms of a
+// verb too (przewidzieć, zestarzeć); where entries overlap the longest
+// wins. Adding a verb is a one-line change.
+
+// inchoativeEcVerbs lists verbs and endings that conjugate -eję:
+// starzeć → starzeję.
+var inchoativeEcVerbs = []string{
+	// Endings that are mostly inchoative (by corpus statistics)
+	"szeć",  // 60%: głuszeć; słyszeć is an action verb
+	"czeć",  // dziczeć, miękczeć
+	"ożeć",  // drożeć, ubożeć, srożeć
+	"urzeć", // durzeć, burzeć
+	"leć",   // maleć, dorośleć; myśleć, woleć are action verbs
+
+	// -rzeć and -żeć verbs from adjectives, against the action default
+	"modrzeć", "mądrzeć", "szarzeć", "starzeć", "chorzeć",
+	"dobrzeć", "srebrzeć", "cukrzeć", "gorzeć", "dorzeć",
+	"dojrzeć", "doźrzeć", "przejrzeć",
+	"tężeć", "zelżeć", "wilżeć", "wężeć", "sfolżeć",
+	"ociężeć", "ściężeć", "sposążeć", "wyryżeć",
+
+	// Other stems the -em and -umieć rules would get wrong
+	"goreć", "źreć", "ochujeć", "dumieć",
+}
+
+// actionEcVerbs lists action verbs with their class: ConjIIa (-ę/-isz)
+// or ConjIIb (-ę/-ysz). Unlike the inchoatives, an entry only matches
+// after prefixes from ecPrefixes, so look-alikes stay inchoative:
+// śniedzieć → śniedzieję, not like siedzieć. Verbs with softening in
+// sg1/pl3 (musieć → muszę, wisieć → wiszę) are irregulars.
+var actionEcVerbs = map[string]byte{
+	"widzieć":    ConjIIa,
+	"siedzieć":   ConjIIa,
+	"lecieć":     ConjIIa,
+	"myśleć":     ConjIIa,
+	"woleć":      ConjIIa,
+	"grzmieć":    ConjIIa,
+	"szumieć":    ConjIIa,
+	"tłumieć":    ConjIIa,
+	"cierpieć":   ConjIIa,
+	"tkwieć":     ConjIIa,
+	"śmierdzieć": ConjIIa,
+	"swędzieć":   ConjIIa,
+	"pierdzieć":  ConjIIa,
+	"skomleć":    ConjIIa,
+	"patrzeć":    ConjIIb,
+	"słyszeć":    ConjIIb,
+}
+
+// ecPrefixes are the prefixes accepted before actionEcVerbs. Besides the
+// ordinary verbal prefixes: nie- (nienawidzieć, zaniewidzieć),
+// współ- (współmyśleć), pół-/wpół- (półsiedzieć).
+var ecPrefixes = append(slices.Clone(verbalPrefixes), "nie", "współ", "pół", "wpół")
+
+// heuristicEcTable conjugates an -eć verb found in inchoativeEcVerbs or
+// actionEcVerbs, reporting false for a verb in neither. heuristicEc
+// handles the rest: -ieć → -ieję, a soft consonant → -ę/-ysz, else -em.
+func heuristicEcTable(infinitive string) (PresentTense, bool) {
+	match, class := "", byte(0)
+	for _, v := range inchoativeEcVerbs {
+		if strings.HasSuffix(infinitive, v) && len(v) > len(match) {
+			match, class = v, ConjI
+		}
+	}
+	for v, c := range actionEcVerbs {
+		prefix, ok := strings.CutSuffix(infinitive, v)
+		if ok && len(v) > len(match) && canStripPrefixes(prefix, ecPrefixes) {
+			match, class = v, c
+		}
+	}
+
+	stem := strings.TrimSuffix(infinitive, "eć")
+	switch class {
+	case ConjI:
+		// starzeć → starzej-: starzeję, starzejesz
+		return presentSpec{stem: stem + "ej", class: ConjI}.build(), true
+	case ConjIIa:
+		// The i softening the stem stays in sg1/pl3 after a labial
+		// (cierpię, grzmię) and is dropped elsewhere (widzę, lecę).
+		hard := strings.TrimSuffix(stem, "i")
+		sg13 := hard
+		if hard != stem && endsInLabial(hard) {
+			sg13 = stem
+		}
+		return presentSpec{stem: hard, sg13: sg13, class: ConjIIa}.build(), true
+	case ConjIIb:
+		return presentSpec{stem: stem, class: ConjIIb}.build(), true
+	}
+	return PresentTense{}, false
+}
+
+// endsInLabial reports whether a stem ends in p, b, m, w or f.
+func endsInLabial(stem string) bool {
+	return stem != "" && strings.ContainsRune("pbmwf", rune(stem[len(stem)-1]))
+}
@@ -0,0 +1,71 @@
+package verb
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+)
+
+// ErrNoMatch is wrapped by the errors returned for verbs the package
+// cannot conjugate: errors.Is(err, ErrNoMatch) holds for every
+// *ConjugationError.
+var ErrNoMatch = errors.New("no heuristic matched")
+
+// ConjugationError reports a verb for which no lookup or heuristic
+// produced the requested form.
+type ConjugationError struct {
+	Infinitive string
+	Form       string // "present", "past", "verbal noun", "imperative", ...
+}
+
+func (e *ConjugationError) Error() string {
+	return fmt.Sprintf("no %s heuristic matched: %s", e.Form, e.Infinitive)
+}
+
+// Unwrap returns ErrNoMatch.
+func (e *ConjugationError) Unwrap() error {
+	return ErrNoMatch
+}
+
+// ErrNotInfinitive is returned for input that cannot be a Polish
+// infinitive, before any heuristic runs: "", "a", "pies".
+var ErrNotInfinitive = errors.New("not an infinitive")
+
+// IsPlausibleInfinitive reports whether s looks like a Polish infinitive:
+// at least three letters ending in a vowel or ś/ź plus -ć (czytać, nieść,
+// gryźć, ciąć) or a vowel plus -c (móc, biec, strzyc), optionally followed
+// by się. Hyphenated loans like e-mailować are accepted. It only checks the shape; a plausible infinitive may still match
+// no heuristic.
+func IsPlausibleInfinitive(s string) bool {
+	base, _ := splitReflexive(s)
+	runes := []rune(base)
+	if len(runes) < 3 {
+		return false
+	}
+	for i, r := range runes {
+		// a hyphen may join letters: e-mailować
+		if !unicode.IsLetter(r) && (r != '-' || i == 0 || runes[i-1] == '-') {
+			return false
+		}
+	}
+	last, prev := runes[len(runes)-1], runes[len(runes)-2]
+	switch last {
+	case 'ć':
+		if prev == 'ś' || prev == 'ź' {
+			return containsVowel(string(runes[:len(runes)-2]))
+		}
+		return isPolishVowel(prev)
+	case 'c':
+		return isPolishVowel(prev)
+	}
+	return false
+}
+
+// checkInfinitive returns an error wrapping ErrNotInfinitive unless s is a
+// plausible infinitive.
+func checkInfinitive(s string) error {
+	if !IsPlausibleInfinitive(s) {
+		return fmt.Errorf("%q: %w", s, ErrNotInfinitive)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package verb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrNoMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		form string
+		call func(string) error
+	}{
+		{"ConjugatePresent", "present", func(s string) error { _, err := ConjugatePresent(s); return err }},
+		{"ConjugatePast", "past", func(s string) error { _, err := ConjugatePast(s); return err }},
+		{"VerbalNoun", "verbal noun", func(s string) error { _, err := VerbalNoun(s); return err }},
+		// Imperative is built on the present and reports its failure
+		{"Imperative", "present", func(s string) error { _, err := Imperative(s); return err }},
+	}
+
+	// xóć is shaped like an infinitive but no heuristic claims -óć
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call("xóć")
+			if !errors.Is(err, ErrNoMatch) {
+				t.Fatalf("%s(xóć) error = %v, want ErrNoMatch", tt.name, err)
+			}
+			var cerr *ConjugationError
+			if !errors.As(err, &cerr) || cerr.Infinitive != "xóć" || cerr.Form != tt.form {
+				t.Errorf("%s(xóć) error = %#v, want ConjugationError{xóć, %s}", tt.name, err, tt.form)
+			}
+		})
+	}
+
+	// Wrapped errors still match
+	if _, err := ConjugateFuture("xóć"); !errors.Is(err, ErrNoMatch) {
+		t.Errorf("ConjugateFuture(xóć) error = %v, want ErrNoMatch", err)
+	}
+}
+
+func TestIsPlausibleInfinitive(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"czytać", true},
+		{"nieść", true},
+		{"gryźć", true},
+		{"ciąć", true},
+		{"dać", true},
+		{"móc", true},
+		{"biec", true},
+		{"strzyc", true},
+		{"bać się", true},
+		{"e-mailować", true},
+		{"", false},
+		{"a", false},
+		{"ć", false},
+		{"ać", false},
+		{"pies", false},
+		{"czytam", false},
+		{"kot", false},
+		{"pieśń", false},
+		{"ść", false},
+		{"stć", false},
+		{"ble ać", false},
+		{"123ć", false},
+		{"-czytać", false},
+		{"e--mailować", false},
+		{"się", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsPlausibleInfinitive(tt.s); got != tt.want {
+			t.Errorf("IsPlausibleInfinitive(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestErrNotInfinitive(t *testing.T) {
+	for _, s := range []string{"", "a", "pies", "czytam"} {
+		if _, err := ConjugatePresent(s); !errors.Is(err, ErrNotInfinitive) {
+			t.Errorf("ConjugatePresent(%q) error = %v, want ErrNotInfinitive", s, err)
+		}
+		if _, err := ConjugatePast(s); !errors.Is(err, ErrNotInfinitive) {
+			t.Errorf("ConjugatePast(%q) error = %v, want ErrNotInfinitive", s, err)
+		}
+		if _, err := VerbalNoun(s); !errors.Is(err, ErrNotInfinitive) {
+			t.Errorf("VerbalNoun(%q) error = %v, want ErrNotInfinitive", s, err)
+		}
+	}
+}
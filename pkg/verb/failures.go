@@ -0,0 +1,53 @@
+package verb
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+)
+
+// Failure is one corpus paradigm the package does not reproduce.
+type Failure struct {
+	Infinitive string
+	// Frequency is the verb's Frequency, by which failures are ranked.
+	Frequency int
+	// Diffs lists the wrong slots against the first generated paradigm,
+	// with the corpus form as Want. It is nil when Err is set.
+	Diffs []SlotDiff
+	// Err is set when conjugation failed outright, e.g. with ErrNoMatch.
+	Err error
+}
+
+// CorpusFailures returns the verbs whose output does not match the
+// embedded corpus, most frequent first, so fixes can be prioritized. A
+// corpus paradigm counts as matched when any generated paradigm equals
+// it; homographs fail once per unmatched paradigm. Only the present
+// corpus is embedded: other tenses, and every tense in a tree built
+// without the corpus, return nil.
+func CorpusFailures(tense Tense) []Failure {
+	if tense != Present {
+		return nil
+	}
+	corpus := presentCorpus()
+	var failures []Failure
+	for _, infinitive := range slices.Sorted(maps.Keys(corpus)) {
+		paradigms, err := ConjugatePresent(infinitive)
+		for _, want := range corpus[infinitive] {
+			f := Failure{Infinitive: infinitive, Frequency: Frequency(infinitive)}
+			if err != nil {
+				f.Err = err
+				failures = append(failures, f)
+				continue
+			}
+			if slices.ContainsFunc(paradigms, func(p Paradigm) bool { return p.PresentTense.Equals(want) }) {
+				continue
+			}
+			f.Diffs = want.Diff(paradigms[0].PresentTense)
+			failures = append(failures, f)
+		}
+	}
+	slices.SortStableFunc(failures, func(a, b Failure) int {
+		return cmp.Compare(b.Frequency, a.Frequency)
+	})
+	return failures
+}
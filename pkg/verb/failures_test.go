@@ -0,0 +1,46 @@
+package verb
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestCorpusFailures(t *testing.T) {
+	czytam := PresentTense{Sg1: "czytam", Sg2: "czytasz", Sg3: "czyta", Pl1: "czytamy", Pl2: "czytacie", Pl3: "czytają"}
+	szeptam := PresentTense{Sg1: "szeptam", Sg2: "szeptasz", Sg3: "szepta", Pl1: "szeptamy", Pl2: "szeptacie", Pl3: "szeptają"}
+	// robić with one slot deliberately wrong
+	robię := PresentTense{Sg1: "robię", Sg2: "robisz", Sg3: "robi", Pl1: "robimy", Pl2: "robicie", Pl3: "robiom"}
+	withPresentCorpus(t, map[string][]PresentTense{
+		"czytać":  {czytam},
+		"szeptać": {szeptam},
+		"robić":   {robię},
+		"xóć":     {czytam},
+	})
+
+	got := CorpusFailures(Present)
+	var infinitives []string
+	for _, f := range got {
+		infinitives = append(infinitives, f.Infinitive)
+	}
+	if want := []string{"robić", "szeptać", "xóć"}; !slices.Equal(infinitives, want) {
+		t.Fatalf("CorpusFailures(Present) = %v, want %v most frequent first", infinitives, want)
+	}
+
+	if want := []SlotDiff{{Slot: Slot{Person: Third, Number: Plural}, Want: "robiom", Got: "robią"}}; !slices.Equal(got[0].Diffs, want) {
+		t.Errorf("robić diffs = %+v, want %+v", got[0].Diffs, want)
+	}
+	if got[0].Frequency == 0 || got[0].Frequency < got[1].Frequency {
+		t.Errorf("frequencies = %d, %d; want robić ranked by its frequency", got[0].Frequency, got[1].Frequency)
+	}
+	if len(got[1].Diffs) == 0 || got[1].Err != nil {
+		t.Errorf("szeptać failure = %+v, want wrong slots", got[1])
+	}
+	if !errors.Is(got[2].Err, ErrNoMatch) || got[2].Diffs != nil {
+		t.Errorf("xóć failure = %+v, want ErrNoMatch", got[2])
+	}
+
+	if got := CorpusFailures(Past); got != nil {
+		t.Errorf("CorpusFailures(Past) = %v, want nil without an embedded past corpus", got)
+	}
+}
@@ -0,0 +1,202 @@
+package verb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConjugationClass is the present-tense conjugation class of a verb, named
+// after its 1sg and 2sg endings.
+type ConjugationClass int
+
+const (
+	ClassUnknown ConjugationClass = iota
+	ClassI                        // -ę, -esz: piszę, piszesz
+	ClassIIa                      // -ę, -isz: robię, robisz
+	ClassIIb                      // -ę, -ysz: uczę, uczysz
+	ClassIII                      // -am, -asz: czytam, czytasz
+	ClassIV                       // -em, -esz: umiem, umiesz
+	ClassIej                      // -eję, -ejesz: starzeję, starzejesz
+)
+
+// String returns the class name: I, Iej, IIa, IIb, III or IV.
+func (c ConjugationClass) String() string {
+	switch c {
+	case ClassUnknown:
+		return "unknown"
+	case ClassI:
+		return "I"
+	case ClassIIa:
+		return "IIa"
+	case ClassIIb:
+		return "IIb"
+	case ClassIII:
+		return "III"
+	case ClassIV:
+		return "IV"
+	case ClassIej:
+		return "Iej"
+	default:
+		return fmt.Sprintf("ConjugationClass(%d)", int(c))
+	}
+}
+
+// Pattern returns the textbook letter of the class: A for -ę/-esz, B for
+// -ę/-isz, C for -ę/-ysz, D for -am/-asz, E for -em/-esz and F for
+// -eję/-ejesz. It returns "" for ClassUnknown.
+func (c ConjugationClass) Pattern() string {
+	switch c {
+	case ClassI:
+		return "A"
+	case ClassIIa:
+		return "B"
+	case ClassIIb:
+		return "C"
+	case ClassIII:
+		return "D"
+	case ClassIV:
+		return "E"
+	case ClassIej:
+		return "F"
+	}
+	return ""
+}
+
+// classOf reads the conjugation class off a present paradigm's 1sg and
+// 2sg, ignoring a reflexive particle.
+func classOf(p PresentTense) ConjugationClass {
+	sg1 := strings.TrimSuffix(p.Sg1, reflexiveParticle)
+	sg2 := strings.TrimSuffix(p.Sg2, reflexiveParticle)
+	switch {
+	case strings.HasSuffix(sg1, "am") && strings.HasSuffix(sg2, "asz"):
+		return ClassIII
+	case strings.HasSuffix(sg1, "em") && strings.HasSuffix(sg2, "esz"):
+		return ClassIV
+	case !strings.HasSuffix(sg1, "ę"):
+		return ClassUnknown
+	case strings.HasSuffix(sg1, "eję") && strings.HasSuffix(sg2, "ejesz"):
+		return ClassIej
+	case strings.HasSuffix(sg2, "esz"):
+		return ClassI
+	case strings.HasSuffix(sg2, "isz"):
+		return ClassIIa
+	case strings.HasSuffix(sg2, "ysz"):
+		return ClassIIb
+	}
+	return ClassUnknown
+}
+
+// Class returns the conjugation class of each of a verb's present
+// paradigms, in ConjugatePresent order: czytać is [III] (pattern D),
+// robić [IIa] (B), pisać [I] (A). Homographs get one class per reading,
+// so stać is [IIa, I] for stoję and stanę. The error is that of
+// ConjugatePresent.
+func Class(infinitive string) ([]ConjugationClass, error) {
+	paradigms, err := ConjugatePresent(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	classes := make([]ConjugationClass, len(paradigms))
+	for i, p := range paradigms {
+		classes[i] = classOf(p.PresentTense)
+	}
+	return classes, nil
+}
+
+// presentClass returns the class of a verb's primary present paradigm,
+// or ClassUnknown when it has none.
+func presentClass(infinitive string) ConjugationClass {
+	paradigms, err := ConjugatePresent(infinitive)
+	if err != nil {
+		return ClassUnknown
+	}
+	return classOf(paradigms[0].PresentTense)
+}
+
+// FamilyMember is a prefixed relative of a base verb.
+type FamilyMember struct {
+	Prefix     string
+	Infinitive string
+	Class      ConjugationClass
+}
+
+// VerbFamily is an unprefixed base verb with its attested prefixed
+// relatives.
+type VerbFamily struct {
+	Base    string
+	Class   ConjugationClass
+	Members []FamilyMember // sorted by prefix
+}
+
+// Family returns the family of a verb: the unprefixed base it resolves
+// to and every attested prefixed form of that base, sorted by prefix.
+// pisać and napisać both give base pisać with members dopisać, napisać,
+// opisać, przepisać... Members carry their own conjugation class, which
+// may differ from the base's.
+//
+// The base is found as for the irregular tables, longest prefix first,
+// so rozebrać resolves to brać and not to ebrać; a verb outside those
+// tables resolves to the longest attested remainder (przepisać →
+// pisać), unless it has an irregular entry of its own (sprzedać). Prefixed
+// iść is spelled -jść, so przyjść resolves to iść with members przyjść,
+// wejść... The reflexive particle is dropped: the family of bać się is
+// that of bać.
+func Family(infinitive string) VerbFamily {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	infinitive, _ = splitReflexive(infinitive)
+	base := familyBase(infinitive)
+
+	stem := base
+	if base == "iść" {
+		stem = "jść"
+	}
+	family := VerbFamily{Base: base, Class: presentClass(base)}
+	for _, pfx := range Prefixes(stem) {
+		member := pfx + stem
+		family.Members = append(family.Members, FamilyMember{
+			Prefix:     pfx,
+			Infinitive: member,
+			Class:      presentClass(member),
+		})
+	}
+	return family
+}
+
+// familyRoots are verbs that look prefixed but are lexemes of their own:
+// umieć is not u+mieć, wlec is not w+lec.
+var familyRoots = map[string]bool{
+	"umieć": true,
+	"wlec":  true,
+}
+
+// familyBase resolves an infinitive to its unprefixed base: first a
+// prefixable irregular base, then any attested remainder. A verb with an
+// irregular entry of its own is its own base unless a prefixable base
+// accounts for it (odebrać is ode+brać, but sprzedać stands alone).
+func familyBase(infinitive string) string {
+	if familyRoots[infinitive] {
+		return infinitive
+	}
+	if prefix, base := stripKnownPrefix(infinitive); prefix != "" {
+		if base == "jść" {
+			return "iść"
+		}
+		return base
+	}
+	if _, ok := irregularSpecs[infinitive]; ok {
+		return infinitive
+	}
+	for _, pfx := range verbPrefixes {
+		rest, ok := strings.CutPrefix(infinitive, pfx)
+		if !ok {
+			continue
+		}
+		if rest == "jść" {
+			return "iść"
+		}
+		if knownInfinitives()[rest] {
+			return rest
+		}
+	}
+	return infinitive
+}
@@ -0,0 +1,127 @@
+package verb
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFamily(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		base       string
+		class      ConjugationClass
+		members    []string // a subset of the members, in order
+	}{
+		{"brać", "brać", ClassI, []string{"odebrać", "rozebrać", "zebrać"}},
+		{"rozebrać", "brać", ClassI, []string{"odebrać", "rozebrać", "zebrać"}},
+		{"napisać", "pisać", ClassI, []string{"dopisać", "napisać", "przepisać"}},
+		{"przyjść", "iść", ClassI, []string{"dojść", "przyjść", "wejść"}},
+		{"zrobić", "robić", ClassIIa, []string{"przerobić", "zrobić"}},
+		{"czytać", "czytać", ClassIII, []string{"przeczytać"}},
+		{"umieć", "umieć", ClassIV, nil},
+		{"wlec", "wlec", ClassI, nil},
+		{"sprzedać", "sprzedać", ClassIII, nil},
+		{"bać się", "bać", ClassIIa, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			f := Family(tt.infinitive)
+			if f.Base != tt.base || f.Class != tt.class {
+				t.Errorf("Family(%q) = base %s class %v, want %s class %v", tt.infinitive, f.Base, f.Class, tt.base, tt.class)
+			}
+			var got []string
+			for _, m := range f.Members {
+				got = append(got, m.Infinitive)
+				if !strings.HasPrefix(m.Infinitive, m.Prefix) {
+					t.Errorf("member %s has prefix %s", m.Infinitive, m.Prefix)
+				}
+			}
+			if !slices.IsSortedFunc(f.Members, func(a, b FamilyMember) int { return strings.Compare(a.Prefix, b.Prefix) }) {
+				t.Errorf("Family(%q) members not sorted by prefix: %v", tt.infinitive, got)
+			}
+			i := 0
+			for _, m := range got {
+				if i < len(tt.members) && m == tt.members[i] {
+					i++
+				}
+			}
+			if i < len(tt.members) {
+				t.Errorf("Family(%q) members = %v, want %v among them", tt.infinitive, got, tt.members)
+			}
+		})
+	}
+}
+
+func TestFamilyIrregularMembers(t *testing.T) {
+	f := Family("brać")
+	want := map[string]string{"odebrać": "odbiorę", "zebrać": "zbiorę", "rozebrać": "rozbiorę"}
+	for _, m := range f.Members {
+		sg1, ok := want[m.Infinitive]
+		if !ok {
+			continue
+		}
+		delete(want, m.Infinitive)
+		if m.Class != ClassI {
+			t.Errorf("%s class = %v, want I", m.Infinitive, m.Class)
+		}
+		if got, err := PresentForm(m.Infinitive, First, Singular); err != nil || got != sg1 {
+			t.Errorf("PresentForm(%s, 1sg) = %q, %v, want %s", m.Infinitive, got, err, sg1)
+		}
+	}
+	for missing := range want {
+		t.Errorf("Family(brać) lacks %s", missing)
+	}
+}
+
+func TestConjugationClassString(t *testing.T) {
+	for c, want := range map[ConjugationClass]string{
+		ClassUnknown: "unknown", ClassI: "I", ClassIIa: "IIa", ClassIIb: "IIb",
+		ClassIII: "III", ClassIV: "IV", ClassIej: "Iej", ConjugationClass(9): "ConjugationClass(9)",
+	} {
+		if got := c.String(); got != want {
+			t.Errorf("ConjugationClass(%d).String() = %q, want %q", int(c), got, want)
+		}
+	}
+}
+
+func TestClass(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       []ConjugationClass
+		pattern    string // of the first paradigm
+	}{
+		{"pisać", []ConjugationClass{ClassI}, "A"},
+		{"robić", []ConjugationClass{ClassIIa}, "B"},
+		{"uczyć się", []ConjugationClass{ClassIIb}, "C"},
+		{"czytać", []ConjugationClass{ClassIII}, "D"},
+		{"umieć", []ConjugationClass{ClassIV}, "E"},
+		{"starzeć się", []ConjugationClass{ClassIej}, "F"},
+		// homograph: one class per reading
+		{"stać", []ConjugationClass{ClassIIa, ClassI}, "B"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := Class(tt.infinitive)
+			if err != nil {
+				t.Fatalf("Class(%q) error: %v", tt.infinitive, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Class(%q) = %v, want %v", tt.infinitive, got, tt.want)
+			}
+			if p := got[0].Pattern(); p != tt.pattern {
+				t.Errorf("Class(%q)[0].Pattern() = %q, want %q", tt.infinitive, p, tt.pattern)
+			}
+		})
+	}
+
+	if _, err := Class("pies"); !errors.Is(err, ErrNotInfinitive) {
+		t.Errorf("Class(pies) error = %v, want ErrNotInfinitive", err)
+	}
+	if p := ClassUnknown.Pattern(); p != "" {
+		t.Errorf("ClassUnknown.Pattern() = %q, want empty", p)
+	}
+}
@@ -0,0 +1,95 @@
+package verb
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrAmbiguous is returned by PresentForm and PastForm for homographs,
+// whose forms depend on which reading is meant: stać → stoi or stanie.
+var ErrAmbiguous = errors.New("homograph has several paradigms")
+
+// PresentForm returns a single present tense form of a verb: czytać,
+// Third, Singular → czyta. Homographs return an error wrapping
+// ErrAmbiguous; PresentFormN selects one of their paradigms.
+func PresentForm(infinitive string, person Person, number Number) (string, error) {
+	paradigms, err := ConjugatePresent(infinitive)
+	if err != nil {
+		return "", err
+	}
+	if len(paradigms) > 1 {
+		return "", fmt.Errorf("%q: %w (%d)", infinitive, ErrAmbiguous, len(paradigms))
+	}
+	return presentSlot(paradigms[0], person, number)
+}
+
+// PresentFormN returns a single present tense form from the idx-th
+// paradigm of a verb, in ConjugatePresent order: stać, 1, Third, Singular
+// → stanie. Index 0 is the only paradigm of a verb that is not a
+// homograph.
+func PresentFormN(infinitive string, idx int, person Person, number Number) (string, error) {
+	paradigms, err := ConjugatePresent(infinitive)
+	if err != nil {
+		return "", err
+	}
+	if idx < 0 || idx >= len(paradigms) {
+		return "", fmt.Errorf("%q has %d present paradigms, no index %d", infinitive, len(paradigms), idx)
+	}
+	return presentSlot(paradigms[idx], person, number)
+}
+
+// presentSlot returns one form of a paradigm, or an error for a person or
+// number outside the paradigm or a slot a defective verb does not use.
+func presentSlot(p Paradigm, person Person, number Number) (string, error) {
+	form := p.Get(person, number)
+	if form == "" {
+		return "", fmt.Errorf("no present form for person %d, number %d", person, number)
+	}
+	if slot := (Slot{Person: person, Number: number}); slices.Contains(p.Defective, slot) {
+		return "", fmt.Errorf("defective verb has no %s form", slot)
+	}
+	return form, nil
+}
+
+// PastForm returns a single past tense form of a verb: nieść, Third,
+// Singular, Masculine → niósł. Homographs return an error wrapping
+// ErrAmbiguous; PastFormN selects one of their paradigms.
+func PastForm(infinitive string, person Person, number Number, gender Gender) (string, error) {
+	paradigms, err := ConjugatePast(infinitive)
+	if err != nil {
+		return "", err
+	}
+	if len(paradigms) > 1 {
+		return "", fmt.Errorf("%q: %w (%d)", infinitive, ErrAmbiguous, len(paradigms))
+	}
+	return pastSlot(paradigms[0], person, number, gender)
+}
+
+// PastFormN returns a single past tense form from the idx-th paradigm of
+// a verb, in ConjugatePast order: paść, 1, Third, Singular, Masculine →
+// pasł. Index 0 is the only paradigm of a verb that is not a homograph.
+func PastFormN(infinitive string, idx int, person Person, number Number, gender Gender) (string, error) {
+	paradigms, err := ConjugatePast(infinitive)
+	if err != nil {
+		return "", err
+	}
+	if idx < 0 || idx >= len(paradigms) {
+		return "", fmt.Errorf("%q has %d past paradigms, no index %d", infinitive, len(paradigms), idx)
+	}
+	return pastSlot(paradigms[idx], person, number, gender)
+}
+
+// pastSlot returns one form of a past paradigm, or an error for a slot
+// outside the paradigm (a neuter first person) or one a defective verb
+// does not use.
+func pastSlot(p PastParadigm, person Person, number Number, gender Gender) (string, error) {
+	form := p.Get(person, number, gender)
+	if form == "" {
+		return "", fmt.Errorf("no past form for person %d, number %d, gender %d", person, number, gender)
+	}
+	if slot := (Slot{Person: person, Number: number, Gender: gender}); slices.Contains(p.Defective, slot) {
+		return "", fmt.Errorf("defective verb has no %s form", slot)
+	}
+	return form, nil
+}
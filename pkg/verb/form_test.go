@@ -0,0 +1,142 @@
+package verb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPresentForm(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		person     Person
+		number     Number
+		want       string
+	}{
+		{"czytać", Third, Singular, "czyta"},
+		{"czytać", First, Plural, "czytamy"},
+		{"pisać", Third, Plural, "piszą"},
+		{"bać się", First, Singular, "boję się"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := PresentForm(tt.infinitive, tt.person, tt.number)
+			if err != nil {
+				t.Fatalf("PresentForm(%q) error: %v", tt.infinitive, err)
+			}
+			if got != tt.want {
+				t.Errorf("PresentForm(%q, %d, %d) = %q, want %q", tt.infinitive, tt.person, tt.number, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPresentFormAmbiguous(t *testing.T) {
+	if _, err := PresentForm("stać", Third, Singular); !errors.Is(err, ErrAmbiguous) {
+		t.Errorf("PresentForm(stać) error = %v, want ErrAmbiguous", err)
+	}
+}
+
+func TestPresentFormN(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		idx        int
+		want       string
+	}{
+		{"stać", 0, "stoi"},
+		{"stać", 1, "stanie"},
+		{"czytać", 0, "czyta"},
+	}
+
+	for _, tt := range tests {
+		got, err := PresentFormN(tt.infinitive, tt.idx, Third, Singular)
+		if err != nil {
+			t.Fatalf("PresentFormN(%q, %d) error: %v", tt.infinitive, tt.idx, err)
+		}
+		if got != tt.want {
+			t.Errorf("PresentFormN(%q, %d) = %q, want %q", tt.infinitive, tt.idx, got, tt.want)
+		}
+	}
+
+	for _, idx := range []int{-1, 2} {
+		if _, err := PresentFormN("stać", idx, Third, Singular); err == nil {
+			t.Errorf("PresentFormN(stać, %d): expected error", idx)
+		}
+	}
+	if _, err := PresentForm("czytać", Person(4), Singular); err == nil {
+		t.Error("PresentForm with unknown person: expected error")
+	}
+	if _, err := PresentForm("xóć", Third, Singular); !errors.Is(err, ErrNoMatch) {
+		t.Errorf("PresentForm(xóć) error = %v, want ErrNoMatch", err)
+	}
+}
+
+func TestPastForm(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		person     Person
+		number     Number
+		gender     Gender
+		want       string
+	}{
+		{"nieść", Third, Singular, Masculine, "niósł"},
+		{"nieść", Third, Plural, MascPersonal, "nieśli"},
+		{"czytać", First, Singular, Feminine, "czytałam"},
+		{"bać się", Third, Singular, Neuter, "bało się"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := PastForm(tt.infinitive, tt.person, tt.number, tt.gender)
+			if err != nil {
+				t.Fatalf("PastForm(%q) error: %v", tt.infinitive, err)
+			}
+			if got != tt.want {
+				t.Errorf("PastForm(%q, %d, %d, %d) = %q, want %q", tt.infinitive, tt.person, tt.number, tt.gender, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPastFormAmbiguous(t *testing.T) {
+	if _, err := PastForm("paść", Third, Singular, Masculine); !errors.Is(err, ErrAmbiguous) {
+		t.Errorf("PastForm(paść) error = %v, want ErrAmbiguous", err)
+	}
+}
+
+func TestPastFormN(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		idx        int
+		want       string
+	}{
+		{"paść", 0, "padł"},
+		{"paść", 1, "pasł"},
+		{"nieść", 0, "niósł"},
+	}
+
+	for _, tt := range tests {
+		got, err := PastFormN(tt.infinitive, tt.idx, Third, Singular, Masculine)
+		if err != nil {
+			t.Fatalf("PastFormN(%q, %d) error: %v", tt.infinitive, tt.idx, err)
+		}
+		if got != tt.want {
+			t.Errorf("PastFormN(%q, %d) = %q, want %q", tt.infinitive, tt.idx, got, tt.want)
+		}
+	}
+
+	for _, idx := range []int{-1, 2} {
+		if _, err := PastFormN("paść", idx, Third, Singular, Masculine); err == nil {
+			t.Errorf("PastFormN(paść, %d): expected error", idx)
+		}
+	}
+	if _, err := PastForm("czytać", First, Singular, Neuter); err == nil {
+		t.Error("PastForm with a neuter first person: expected error")
+	}
+	if _, err := PastForm("dnieć", First, Singular, Masculine); err == nil {
+		t.Error("PastForm(dnieć) first person: expected defective error")
+	}
+	if _, err := PastForm("xóć", Third, Singular, Masculine); !errors.Is(err, ErrNoMatch) {
+		t.Errorf("PastForm(xóć) error = %v, want ErrNoMatch", err)
+	}
+}
@@ -0,0 +1,183 @@
+package verb
+
+import (
+	"cmp"
+	_ "embed"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:generate go run ../../cmd/gendata
+
+// frequencyData is the embedded frequency lexicon: one
+// "infinitive<TAB>aspect<TAB>frequency" line per verb, generated by cmd/gendata
+// from the past corpus and OpenSubtitles word counts.
+//
+//go:embed data/frequency.tsv
+var frequencyData string
+
+// paradigmFrequencyData ranks the paradigms of homographs: one
+// "tense<TAB>infinitive<TAB>form<TAB>frequency" line per paradigm, where
+// form is its sg1 (present) or sg3m (past), generated by cmd/gendata from
+// OpenSubtitles word counts. Homographs with no counts are left out.
+//
+//go:embed data/paradigm_frequency.tsv
+var paradigmFrequencyData string
+
+// paradigmFrequency parses paradigmFrequencyData on first use, keyed by
+// tense, infinitive and form joined with tabs.
+var paradigmFrequency = sync.OnceValue(func() map[string]int {
+	index := make(map[string]int)
+	for line := range strings.Lines(paradigmFrequencyData) {
+		fields := strings.Split(strings.TrimSpace(line), "\t")
+		if len(fields) != 4 {
+			continue
+		}
+		freq, err := strconv.Atoi(fields[3])
+		if err != nil {
+			continue
+		}
+		index[strings.Join(fields[:3], "\t")] = freq
+	}
+	return index
+})
+
+// paradigmRank returns the frequency of one paradigm of a homograph,
+// identified by its sg1 (present) or sg3m (past), or -1 when there is no
+// data for it. The particle of reflexive verbs is ignored.
+func paradigmRank(tense Tense, infinitive, form string) int {
+	base, _ := splitReflexive(infinitive)
+	form = strings.TrimSuffix(form, reflexiveParticle)
+	if freq, ok := paradigmFrequency()[tense.String()+"\t"+base+"\t"+form]; ok {
+		return freq
+	}
+	return -1
+}
+
+// sortParadigmsByFrequency orders the paradigms of a homograph most
+// frequent first. Paradigms without frequency data keep their order,
+// after the ranked ones.
+func sortParadigmsByFrequency[P any](paradigms []P, rank func(P) int) {
+	if len(paradigms) < 2 {
+		return
+	}
+	slices.SortStableFunc(paradigms, func(a, b P) int {
+		return cmp.Compare(rank(b), rank(a))
+	})
+}
+
+// ConjugatePresentPrimary returns a single present tense paradigm: the
+// most frequent one for homographs, so stać → stoję (to stand) rather than
+// stanę (to become). It discards the alternative meanings; use
+// ConjugatePresent to see them. Homographs without frequency data get
+// their first paradigm.
+func ConjugatePresentPrimary(infinitive string) (PresentTense, error) {
+	paradigms, err := ConjugatePresent(infinitive)
+	if err != nil {
+		return PresentTense{}, err
+	}
+	return paradigms[0].PresentTense, nil
+}
+
+// lexiconEntry is one verb of the frequency lexicon.
+type lexiconEntry struct {
+	infinitive string
+	aspect     AspectClass
+	freq       int
+}
+
+// lexicon parses frequencyData on first use.
+var lexicon = sync.OnceValue(func() []lexiconEntry {
+	var entries []lexiconEntry
+	for line := range strings.Lines(frequencyData) {
+		fields := strings.Split(strings.TrimSpace(line), "\t")
+		if len(fields) != 3 {
+			continue
+		}
+		freq, err := strconv.Atoi(fields[2])
+		if err != nil {
+			continue
+		}
+		entries = append(entries, lexiconEntry{
+			infinitive: fields[0],
+			aspect:     parseAspectTag(fields[1]),
+			freq:       freq,
+		})
+	}
+	return entries
+})
+
+// lexiconIndex maps infinitives to their lexicon entries.
+var lexiconIndex = sync.OnceValue(func() map[string]lexiconEntry {
+	index := make(map[string]lexiconEntry, len(lexicon()))
+	for _, e := range lexicon() {
+		index[e.infinitive] = e
+	}
+	return index
+})
+
+// Frequency returns how often a verb occurs in the OpenSubtitles frequency
+// list: the highest count among its infinitive and past tense forms.
+// Returns 0 for verbs that do not occur.
+func Frequency(infinitive string) int {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	return lexiconIndex()[infinitive].freq
+}
+
+// SampleOpts configures SampleVerbs.
+type SampleOpts struct {
+	// Seed makes sampling reproducible: the same seed, options and n
+	// always return the same verbs.
+	Seed uint64
+	// Aspect restricts the sample to one aspect. Zero means any aspect.
+	Aspect AspectClass
+	// Filter, if set, keeps only verbs for which it returns true
+	// (e.g. a conjugation class check).
+	Filter func(infinitive string) bool
+}
+
+// SampleVerbs returns up to n distinct verbs drawn at random, weighted by
+// corpus frequency, so common verbs (być, robić, mówić) come up far more
+// often than rare ones. Verbs are returned in draw order.
+func SampleVerbs(n int, opts SampleOpts) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	// Weighted sampling without replacement (Efraimidis–Spirakis):
+	// each verb gets key u^(1/w); the n largest keys win. Keys are
+	// compared as log(u)/w, which orders the same but keeps precision
+	// for weights in the hundreds of thousands.
+	type keyed struct {
+		infinitive string
+		key        float64
+	}
+	rng := rand.New(rand.NewPCG(opts.Seed, opts.Seed))
+	var pool []keyed
+	for _, e := range lexicon() {
+		if opts.Aspect != 0 && e.aspect != opts.Aspect {
+			continue
+		}
+		if opts.Filter != nil && !opts.Filter(e.infinitive) {
+			continue
+		}
+		key := math.Log(rng.Float64()) / float64(e.freq)
+		pool = append(pool, keyed{e.infinitive, key})
+	}
+
+	sort.Slice(pool, func(i, j int) bool {
+		return pool[i].key > pool[j].key
+	})
+
+	n = min(n, len(pool))
+	verbs := make([]string, n)
+	for i := range verbs {
+		verbs[i] = pool[i].infinitive
+	}
+	return verbs
+}
@@ -0,0 +1,151 @@
+package verb
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFrequency(t *testing.T) {
+	if got := Frequency("być"); got < Frequency("robić") {
+		t.Errorf("Frequency(być) = %d, want more than robić (%d)", got, Frequency("robić"))
+	}
+	if got := Frequency("nieistniejąć"); got != 0 {
+		t.Errorf("Frequency(nieistniejąć) = %d, want 0", got)
+	}
+}
+
+func TestConjugatePresentPrimary(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+	}{
+		{"stać", "stoję"},   // to stand outranks to become
+		{"wysłać", "wyślę"}, // to send outranks to spread
+		{"słać", "ślę"},     // no counts: first paradigm
+		{"czytać", "czytam"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresentPrimary(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresentPrimary(%q) error: %v", tt.infinitive, err)
+			}
+			if got.Sg1 != tt.wantSg1 {
+				t.Errorf("ConjugatePresentPrimary(%q).Sg1 = %q, want %q", tt.infinitive, got.Sg1, tt.wantSg1)
+			}
+		})
+	}
+
+	if _, err := ConjugatePresentPrimary("xyz"); err == nil {
+		t.Error("ConjugatePresentPrimary(xyz) succeeded, want an error")
+	}
+}
+
+// TestConjugatePresentPrimaryRanking checks that the ranking, not the
+// paradigm order, decides: with stanę counted higher, stać → stanę.
+func TestConjugatePresentPrimaryRanking(t *testing.T) {
+	saved := paradigmFrequency
+	defer func() { paradigmFrequency = saved }()
+	paradigmFrequency = func() map[string]int {
+		return map[string]int{"present\tstać\tstoję": 10, "present\tstać\tstanę": 20}
+	}
+
+	got, err := ConjugatePresentPrimary("stać")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Sg1 != "stanę" {
+		t.Errorf("ConjugatePresentPrimary(stać).Sg1 = %q, want stanę", got.Sg1)
+	}
+}
+
+func TestParadigmFrequencyOrder(t *testing.T) {
+	present, err := ConjugatePresent("stać")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := []string{present[0].Sg1, present[1].Sg1}; !slices.Equal(got, []string{"stoję", "stanę"}) {
+		t.Errorf("ConjugatePresent(stać) order = %v, want [stoję stanę]", got)
+	}
+
+	// padł (to fall) outranks pasł (to graze), and zniknął outranks znikł
+	for _, tt := range []struct{ infinitive, want string }{
+		{"paść", "padł"},
+		{"zniknąć", "zniknął"},
+		{"paść się", "padł się"},
+	} {
+		past, err := ConjugatePast(tt.infinitive)
+		if err != nil {
+			t.Fatalf("ConjugatePast(%q) error: %v", tt.infinitive, err)
+		}
+		if past[0].Sg3M != tt.want {
+			t.Errorf("ConjugatePast(%q)[0].Sg3M = %q, want %q", tt.infinitive, past[0].Sg3M, tt.want)
+		}
+	}
+}
+
+func TestSampleVerbsDeterministic(t *testing.T) {
+	a := SampleVerbs(20, SampleOpts{Seed: 42})
+	b := SampleVerbs(20, SampleOpts{Seed: 42})
+	if len(a) != 20 {
+		t.Fatalf("SampleVerbs(20) returned %d verbs", len(a))
+	}
+	if !slices.Equal(a, b) {
+		t.Errorf("SampleVerbs not deterministic for the same seed:\n%v\n%v", a, b)
+	}
+
+	seen := make(map[string]bool)
+	for _, v := range a {
+		if seen[v] {
+			t.Errorf("SampleVerbs returned %q twice", v)
+		}
+		seen[v] = true
+	}
+
+	if got := SampleVerbs(0, SampleOpts{}); got != nil {
+		t.Errorf("SampleVerbs(0) = %v, want nil", got)
+	}
+}
+
+func TestSampleVerbsWeighting(t *testing.T) {
+	// być is by far the most frequent verb; the least frequent verb in the
+	// lexicon should almost never be drawn.
+	rarest := slices.MinFunc(lexicon(), func(a, b lexiconEntry) int { return a.freq - b.freq })
+
+	common, rare := 0, 0
+	for seed := range uint64(100) {
+		sample := SampleVerbs(10, SampleOpts{Seed: seed})
+		if slices.Contains(sample, "być") {
+			common++
+		}
+		if slices.Contains(sample, rarest.infinitive) {
+			rare++
+		}
+	}
+	if common < 30 || rare > 2 {
+		t.Errorf("in 100 samples of 10: być drawn %d times, %s drawn %d times",
+			common, rarest.infinitive, rare)
+	}
+}
+
+func TestSampleVerbsFilters(t *testing.T) {
+	perf := SampleVerbs(30, SampleOpts{Seed: 1, Aspect: Perfective})
+	for _, v := range perf {
+		if e := lexiconIndex()[v]; e.aspect != Perfective {
+			t.Errorf("SampleVerbs(Aspect: Perfective) returned %q (%v)", v, e.aspect)
+		}
+	}
+
+	owac := func(inf string) bool { return strings.HasSuffix(inf, "ować") }
+	sample := SampleVerbs(30, SampleOpts{Seed: 1, Filter: owac})
+	if len(sample) != 30 {
+		t.Fatalf("SampleVerbs(30, Filter) returned %d verbs", len(sample))
+	}
+	for _, v := range sample {
+		if !owac(v) {
+			t.Errorf("SampleVerbs(Filter) returned %q", v)
+		}
+	}
+}
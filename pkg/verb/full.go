@@ -0,0 +1,80 @@
+package verb
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FullParadigm gathers every conjugation of a verb. Sections that could
+// not be built are left empty and their errors recorded in Errors, keyed
+// by section name: "present", "past", "verbalNoun", "future",
+// "conditional", "imperative", "passiveParticiple", "activeParticiple",
+// "contemporaryAdverbial", "anteriorAdverbial" and "impersonalPast".
+//
+// Participles that do not exist for the verb's aspect are recorded too,
+// so errors.Is(p.Errors["activeParticiple"], ErrNoActiveParticiple)
+// tells a perfective verb apart from a failed derivation. Errors is left
+// out of JSON, which cannot encode error values.
+type FullParadigm struct {
+	Infinitive            string                `json:"infinitive"`
+	Present               []Paradigm            `json:"present,omitempty"`
+	Past                  []PastParadigm        `json:"past,omitempty"`
+	VerbalNoun            []string              `json:"verbalNoun,omitempty"`
+	Future                []FutureParadigm      `json:"future,omitempty"`
+	Conditional           []ConditionalParadigm `json:"conditional,omitempty"`
+	Imperative            []ImperativeParadigm  `json:"imperative,omitempty"`
+	PassiveParticiple     []string              `json:"passiveParticiple,omitempty"`
+	ActiveParticiple      string                `json:"activeParticiple,omitempty"`
+	ContemporaryAdverbial string                `json:"contemporaryAdverbial,omitempty"`
+	AnteriorAdverbial     string                `json:"anteriorAdverbial,omitempty"`
+	ImpersonalPast        string                `json:"impersonalPast,omitempty"`
+	Errors                map[string]error      `json:"-"`
+}
+
+// ConjugateAll conjugates a verb in every tense, mood and participle the
+// package supports. It fills in what it can: a verb whose past cannot be
+// derived still gets its present. An error is returned only when no
+// section could be built.
+func ConjugateAll(infinitive string) (*FullParadigm, error) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	p := &FullParadigm{Infinitive: infinitive, Errors: make(map[string]error)}
+	var errs []error
+	record := func(section string, err error) {
+		if err != nil {
+			p.Errors[section] = err
+			errs = append(errs, fmt.Errorf("%s: %w", section, err))
+		}
+	}
+
+	var err error
+	p.Present, err = ConjugatePresent(infinitive)
+	record("present", err)
+	p.Past, err = ConjugatePast(infinitive)
+	record("past", err)
+	p.VerbalNoun, err = VerbalNoun(infinitive)
+	record("verbalNoun", err)
+	p.Future, err = ConjugateFuture(infinitive)
+	record("future", err)
+	p.Conditional, err = ConjugateConditional(infinitive)
+	record("conditional", err)
+	p.Imperative, err = Imperative(infinitive)
+	record("imperative", err)
+	p.PassiveParticiple, err = PassiveParticiple(infinitive)
+	record("passiveParticiple", err)
+	p.ActiveParticiple, err = ActiveParticiple(infinitive)
+	record("activeParticiple", err)
+	p.ContemporaryAdverbial, err = ContemporaryAdverbial(infinitive)
+	record("contemporaryAdverbial", err)
+	p.AnteriorAdverbial, err = AnteriorAdverbial(infinitive)
+	record("anteriorAdverbial", err)
+	p.ImpersonalPast, err = ImpersonalPast(infinitive)
+	record("impersonalPast", err)
+
+	if len(errs) == fullParadigmSections {
+		return nil, fmt.Errorf("cannot conjugate %q: %w", infinitive, errors.Join(errs...))
+	}
+	return p, nil
+}
+
+// fullParadigmSections is the number of sections ConjugateAll fills.
+const fullParadigmSections = 11
@@ -0,0 +1,44 @@
+package verb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConjugateAll(t *testing.T) {
+	p, err := ConjugateAll("czytać")
+	if err != nil {
+		t.Fatalf("ConjugateAll(czytać) error: %v", err)
+	}
+	if p.Present[0].Sg1 != "czytam" || p.Past[0].Sg3F != "czytała" || p.VerbalNoun[0] != "czytanie" {
+		t.Errorf("ConjugateAll(czytać) = %+v, want czytam, czytała, czytanie", p)
+	}
+	if p.Imperative[0].Sg2 != "czytaj" || p.Conditional[0].Sg1M != "czytałbym" {
+		t.Errorf("ConjugateAll(czytać) imperative/conditional = %v, %v", p.Imperative, p.Conditional)
+	}
+	if p.ActiveParticiple != "czytający" || p.ImpersonalPast != "czytano" {
+		t.Errorf("ConjugateAll(czytać) participles = %q, %q", p.ActiveParticiple, p.ImpersonalPast)
+	}
+	// Imperfective: no anterior adverbial, and nothing else missing
+	if !errors.Is(p.Errors["anteriorAdverbial"], ErrNoAnteriorAdverbial) || len(p.Errors) != 1 {
+		t.Errorf("ConjugateAll(czytać) Errors = %v, want only anteriorAdverbial", p.Errors)
+	}
+}
+
+func TestConjugateAllPartial(t *testing.T) {
+	// bość has a past but no present heuristic
+	p, err := ConjugateAll("bość")
+	if err != nil {
+		t.Fatalf("ConjugateAll(bość) error: %v", err)
+	}
+	if p.Present != nil || p.Errors["present"] == nil {
+		t.Errorf("ConjugateAll(bość) present = %v, error %v; want none", p.Present, p.Errors["present"])
+	}
+	if len(p.Past) == 0 || p.Past[0].Sg3M != "bódł" {
+		t.Errorf("ConjugateAll(bość) past = %v, want bódł", p.Past)
+	}
+
+	if _, err := ConjugateAll("xyz"); err == nil {
+		t.Error("ConjugateAll(xyz) should fail")
+	}
+}
@@ -0,0 +1,232 @@
+package verb
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FutureParadigm is one future tense paradigm of a verb.
+//
+// Perfective verbs have a synthetic future, which is their present-tense
+// form: napiszę, napiszesz... Participle is nil.
+//
+// Imperfective verbs have an analytic future built from będę plus the
+// infinitive, held in the six person/number slots (będę czytać), and an
+// equivalent variant with the gendered l-participle, held in Participle
+// (będę czytał, będę czytała...).
+type FutureParadigm struct {
+	PresentTense
+	Participle *PastTense `json:"participle,omitempty"` // analytic future with the l-participle; nil if synthetic
+	Gloss      string     `json:"gloss,omitempty"`      // e.g. "perfective"
+}
+
+// Analytic reports whether the paradigm is the compound będę + verb future.
+// A paradigm narrowed to one style by WithStyle is still analytic.
+func (p FutureParadigm) Analytic() bool {
+	return p.Participle != nil || strings.HasPrefix(strings.ToLower(p.Sg1), "będę ")
+}
+
+// FutureStyle selects the constructions of the analytic future.
+type FutureStyle int
+
+const (
+	FutureBoth       FutureStyle = iota // będę czytać and będę czytał
+	FutureInfinitive                    // będę czytać
+	FutureParticiple                    // będę czytał, będę czytała
+)
+
+// String returns the short name of the style, as accepted by
+// ParseFutureStyle.
+func (s FutureStyle) String() string {
+	switch s {
+	case FutureBoth:
+		return "both"
+	case FutureInfinitive:
+		return "infinitive"
+	case FutureParticiple:
+		return "participle"
+	default:
+		return fmt.Sprintf("FutureStyle(%d)", int(s))
+	}
+}
+
+// ParseFutureStyle parses a style name: both, infinitive or participle.
+func ParseFutureStyle(s string) (FutureStyle, error) {
+	switch s {
+	case "both":
+		return FutureBoth, nil
+	case "infinitive":
+		return FutureInfinitive, nil
+	case "participle":
+		return FutureParticiple, nil
+	default:
+		return 0, fmt.Errorf("unknown future style: %q (want both, infinitive or participle)", s)
+	}
+}
+
+// WithStyle narrows an analytic paradigm to one construction: FutureInfinitive
+// drops Participle, FutureParticiple empties the six infinitive slots.
+// Synthetic paradigms have one construction and are returned unchanged.
+func (p FutureParadigm) WithStyle(style FutureStyle) FutureParadigm {
+	if p.Participle == nil {
+		return p
+	}
+	switch style {
+	case FutureInfinitive:
+		p.Participle = nil
+	case FutureParticiple:
+		p.PresentTense = PresentTense{}
+	}
+	return p
+}
+
+// ConjugateFutureStyle is ConjugateFuture with the analytic paradigms
+// narrowed to style: czytać with FutureParticiple gives only będę czytał,
+// będę czytała... ConjugateFuture is FutureBoth.
+func ConjugateFutureStyle(infinitive string, style FutureStyle) ([]FutureParadigm, error) {
+	paradigms, err := ConjugateFuture(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range paradigms {
+		paradigms[i] = p.WithStyle(style)
+	}
+	return paradigms, nil
+}
+
+// ConjugateFuture returns the future tense paradigms of a verb.
+//
+// The aspect decides the construction: perfective verbs get the synthetic
+// future (napisać → napiszę) and imperfective verbs the analytic one
+// (czytać → będę czytać / będę czytał). Biaspectual verbs (aresztować) and
+// verbs of unknown aspect get both, synthetic first. być is its own future
+// (będę, będziesz...), not an auxiliary.
+func ConjugateFuture(infinitive string) ([]FutureParadigm, error) {
+	infinitive, capital := normalizeInfinitive(infinitive)
+	paradigms, err := conjugateFuture(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	if capital {
+		for i, p := range paradigms {
+			paradigms[i].PresentTense = p.capitalized()
+			if p.Participle != nil {
+				participle := p.Participle.capitalized()
+				paradigms[i].Participle = &participle
+			}
+		}
+	}
+	return paradigms, nil
+}
+
+// conjugateFuture finds the future paradigms of a normalized infinitive.
+func conjugateFuture(infinitive string) ([]FutureParadigm, error) {
+	if infinitive == "być" {
+		return []FutureParadigm{{PresentTense: buildBedPresent("")}}, nil
+	}
+
+	aspect, _ := Aspect(infinitive)
+	var paradigms []FutureParadigm
+	var errs []error
+
+	if hasPresentFormsAsFuture(infinitive) {
+		synthetic, err := conjugateSyntheticFuture(infinitive)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		paradigms = append(paradigms, synthetic...)
+	}
+	if aspect != Perfective {
+		analytic, err := conjugateAnalyticFuture(infinitive)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		paradigms = append(paradigms, analytic...)
+	}
+
+	if len(paradigms) == 0 {
+		return nil, fmt.Errorf("cannot derive future for %q: %w", infinitive, errors.Join(errs...))
+	}
+	if aspect != Imperfective && aspect != Perfective {
+		for i := range paradigms {
+			if paradigms[i].Gloss == "" {
+				paradigms[i].Gloss = futureGloss(paradigms[i].Analytic())
+			}
+		}
+	}
+	return paradigms, nil
+}
+
+// hasPresentFormsAsFuture reports whether a verb's present-tense forms
+// are its future: napisać → napiszę (I will write). That holds for
+// perfective verbs and for być, whose będę is a future of its own.
+// Biaspectual verbs and verbs of unknown aspect may be read as
+// perfective, so they report true as well.
+//
+// Imperfective verbs report false: their present is a true present
+// (piszę, I write), and their future needs the analytic będę + verb.
+func hasPresentFormsAsFuture(infinitive string) bool {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	if infinitive == "być" {
+		return true
+	}
+	aspect, _ := Aspect(infinitive)
+	return aspect != Imperfective
+}
+
+// futureGloss labels the two readings of a verb whose aspect is ambiguous.
+func futureGloss(analytic bool) string {
+	if analytic {
+		return "imperfective"
+	}
+	return "perfective"
+}
+
+// conjugateSyntheticFuture returns the present-tense forms as future:
+// napisać → napiszę. Homographs keep their glosses.
+func conjugateSyntheticFuture(infinitive string) ([]FutureParadigm, error) {
+	present, err := ConjugatePresent(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	paradigms := make([]FutureParadigm, len(present))
+	for i, p := range present {
+		paradigms[i] = FutureParadigm{PresentTense: p.PresentTense, Gloss: p.Gloss}
+	}
+	return paradigms, nil
+}
+
+// conjugateAnalyticFuture builds będę + infinitive and będę + l-participle
+// from the verb's primary past paradigm: czytać → będę czytać, będę czytał.
+// A reflexive verb's się is a clitic and follows the auxiliary: bać się →
+// będę się bać, będę się bał.
+func conjugateAnalyticFuture(infinitive string) ([]FutureParadigm, error) {
+	base, reflexive := splitReflexive(infinitive)
+	past, err := ConjugatePast(base)
+	if err != nil {
+		return nil, err
+	}
+	aux := buildBedPresent("")
+	if reflexive {
+		aux = aux.withReflexive()
+	}
+	l := past[0].PastTense
+
+	with := func(a, form string) string { return a + " " + form }
+
+	return []FutureParadigm{{
+		PresentTense: PresentTense{
+			Sg1: with(aux.Sg1, base), Sg2: with(aux.Sg2, base), Sg3: with(aux.Sg3, base),
+			Pl1: with(aux.Pl1, base), Pl2: with(aux.Pl2, base), Pl3: with(aux.Pl3, base),
+		},
+		Participle: &PastTense{
+			Sg1M: with(aux.Sg1, l.Sg3M), Sg1F: with(aux.Sg1, l.Sg3F),
+			Sg2M: with(aux.Sg2, l.Sg3M), Sg2F: with(aux.Sg2, l.Sg3F),
+			Sg3M: with(aux.Sg3, l.Sg3M), Sg3F: with(aux.Sg3, l.Sg3F), Sg3N: with(aux.Sg3, l.Sg3N),
+			Pl1V: with(aux.Pl1, l.Pl3V), Pl1NV: with(aux.Pl1, l.Pl3NV),
+			Pl2V: with(aux.Pl2, l.Pl3V), Pl2NV: with(aux.Pl2, l.Pl3NV),
+			Pl3V: with(aux.Pl3, l.Pl3V), Pl3NV: with(aux.Pl3, l.Pl3NV),
+		},
+	}}, nil
+}
@@ -0,0 +1,182 @@
+package verb
+
+import "testing"
+
+func TestConjugateFuture(t *testing.T) {
+	tests := []struct {
+		infinitive    string
+		wantSynthetic string // Sg1 of the synthetic paradigm, "" if none
+		wantAnalytic  string // Sg1 of the analytic paradigm, "" if none
+	}{
+		{"napisać", "napiszę", ""},                     // perfective
+		{"zrobić", "zrobię", ""},                       // perfective
+		{"czytać", "", "będę czytać"},                  // imperfective
+		{"móc", "", "będę móc"},                        // imperfectiva tantum
+		{"aresztować", "aresztuję", "będę aresztować"}, // biaspectual
+		{"wyczytywać", "", "będę wyczytywać"},          // secondary imperfective
+		{"tlić", "tlę", "będę tlić"},                   // unknown aspect
+		{"bać się", "", "będę się bać"},                // reflexive: się after będę
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			paradigms, err := ConjugateFuture(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugateFuture(%q) error: %v", tt.infinitive, err)
+			}
+			var synthetic, analytic string
+			for _, p := range paradigms {
+				if p.Analytic() {
+					analytic = p.Sg1
+				} else {
+					synthetic = p.Sg1
+				}
+			}
+			if synthetic != tt.wantSynthetic || analytic != tt.wantAnalytic {
+				t.Errorf("ConjugateFuture(%q) = synthetic %q, analytic %q; want %q, %q",
+					tt.infinitive, synthetic, analytic, tt.wantSynthetic, tt.wantAnalytic)
+			}
+		})
+	}
+}
+
+func TestConjugateFutureParticiple(t *testing.T) {
+	paradigms, err := ConjugateFuture("czytać")
+	if err != nil {
+		t.Fatalf("ConjugateFuture error: %v", err)
+	}
+	if len(paradigms) != 1 || !paradigms[0].Analytic() {
+		t.Fatalf("ConjugateFuture(czytać) = %+v, want one analytic paradigm", paradigms)
+	}
+	p := paradigms[0]
+
+	want := PresentTense{
+		Sg1: "będę czytać", Sg2: "będziesz czytać", Sg3: "będzie czytać",
+		Pl1: "będziemy czytać", Pl2: "będziecie czytać", Pl3: "będą czytać",
+	}
+	if !p.PresentTense.Equals(want) {
+		t.Errorf("infinitive forms = %+v, want %+v", p.PresentTense, want)
+	}
+
+	wantParticiple := PastTense{
+		Sg1M: "będę czytał", Sg1F: "będę czytała",
+		Sg2M: "będziesz czytał", Sg2F: "będziesz czytała",
+		Sg3M: "będzie czytał", Sg3F: "będzie czytała", Sg3N: "będzie czytało",
+		Pl1V: "będziemy czytali", Pl1NV: "będziemy czytały",
+		Pl2V: "będziecie czytali", Pl2NV: "będziecie czytały",
+		Pl3V: "będą czytali", Pl3NV: "będą czytały",
+	}
+	if !p.Participle.Equals(wantParticiple) {
+		t.Errorf("participle forms = %+v, want %+v", *p.Participle, wantParticiple)
+	}
+}
+
+func TestConjugateFutureReflexive(t *testing.T) {
+	paradigms, err := ConjugateFuture("bać się")
+	if err != nil {
+		t.Fatalf("ConjugateFuture error: %v", err)
+	}
+	p := paradigms[0]
+	if p.Pl3 != "będą się bać" || p.Participle.Sg1F != "będę się bała" || p.Participle.Pl3V != "będą się bali" {
+		t.Errorf("ConjugateFuture(bać się) = %s, %s, %s; want będą się bać, będę się bała, będą się bali",
+			p.Pl3, p.Participle.Sg1F, p.Participle.Pl3V)
+	}
+
+	// przestraszyć się is perfective: the synthetic future keeps się last
+	paradigms, err = ConjugateFuture("przestraszyć się")
+	if err != nil {
+		t.Fatalf("ConjugateFuture error: %v", err)
+	}
+	if p := paradigms[0]; len(paradigms) != 1 || p.Sg1 != "przestraszę się" {
+		t.Errorf("ConjugateFuture(przestraszyć się) = %+v, want przestraszę się", paradigms)
+	}
+}
+
+func TestConjugateFutureCapitalized(t *testing.T) {
+	paradigms, err := ConjugateFuture("Czytać")
+	if err != nil {
+		t.Fatalf("ConjugateFuture error: %v", err)
+	}
+	if p := paradigms[0]; p.Sg1 != "Będę czytać" || p.Participle.Sg3F != "Będzie czytała" {
+		t.Errorf("ConjugateFuture(Czytać) = %s, %s; want Będę czytać, Będzie czytała", p.Sg1, p.Participle.Sg3F)
+	}
+
+	paradigms, err = ConjugateFuture("Napisać")
+	if err != nil {
+		t.Fatalf("ConjugateFuture error: %v", err)
+	}
+	if p := paradigms[0]; p.Sg1 != "Napiszę" {
+		t.Errorf("ConjugateFuture(Napisać) = %s, want Napiszę", p.Sg1)
+	}
+}
+
+func TestConjugateFutureByc(t *testing.T) {
+	paradigms, err := ConjugateFuture("być")
+	if err != nil {
+		t.Fatalf("ConjugateFuture error: %v", err)
+	}
+	if len(paradigms) != 1 || paradigms[0].Analytic() {
+		t.Fatalf("ConjugateFuture(być) = %+v, want one synthetic paradigm", paradigms)
+	}
+	if got := paradigms[0]; got.Sg1 != "będę" || got.Pl3 != "będą" {
+		t.Errorf("ConjugateFuture(być) = %s … %s, want będę … będą", got.Sg1, got.Pl3)
+	}
+}
+
+func TestHasPresentFormsAsFuture(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       bool
+	}{
+		{"napisać", true},
+		{"przeczytać", true},
+		{"aresztować", true}, // biaspectual: the perfective reading
+		{"być", true},
+		{"pisać", false},
+		{"czytać", false},
+		{"pisać się", false},
+	}
+	for _, tt := range tests {
+		if got := hasPresentFormsAsFuture(tt.infinitive); got != tt.want {
+			t.Errorf("hasPresentFormsAsFuture(%q) = %v, want %v", tt.infinitive, got, tt.want)
+		}
+	}
+}
+
+func TestConjugateFutureStyle(t *testing.T) {
+	infinitive, err := ConjugateFutureStyle("czytać", FutureInfinitive)
+	if err != nil {
+		t.Fatalf("ConjugateFutureStyle error: %v", err)
+	}
+	if p := infinitive[0]; p.Sg1 != "będę czytać" || p.Participle != nil || !p.Analytic() {
+		t.Errorf("FutureInfinitive = %+v, want będę czytać without the participle", p)
+	}
+
+	participle, err := ConjugateFutureStyle("czytać", FutureParticiple)
+	if err != nil {
+		t.Fatalf("ConjugateFutureStyle error: %v", err)
+	}
+	if p := participle[0]; p.Sg1 != "" || p.Participle == nil || p.Participle.Sg1F != "będę czytała" || !p.Analytic() {
+		t.Errorf("FutureParticiple = %+v, want only będę czytał...", p)
+	}
+
+	// synthetic futures have one construction
+	synthetic, err := ConjugateFutureStyle("napisać", FutureParticiple)
+	if err != nil {
+		t.Fatalf("ConjugateFutureStyle error: %v", err)
+	}
+	if p := synthetic[0]; p.Sg1 != "napiszę" || p.Analytic() {
+		t.Errorf("FutureParticiple(napisać) = %+v, want napiszę", p)
+	}
+}
+
+func TestParseFutureStyle(t *testing.T) {
+	for _, s := range []FutureStyle{FutureBoth, FutureInfinitive, FutureParticiple} {
+		if got, err := ParseFutureStyle(s.String()); err != nil || got != s {
+			t.Errorf("ParseFutureStyle(%q) = %v, %v; want %v", s.String(), got, err, s)
+		}
+	}
+	if _, err := ParseFutureStyle("gerund"); err == nil {
+		t.Error("ParseFutureStyle(gerund) should fail")
+	}
+}
@@ -0,0 +1,41 @@
+package verb
+
+import (
+	"strings"
+	"testing"
+)
+
+// FuzzConjugate feeds arbitrary strings to the conjugation entry points,
+// which must not panic and must return either forms or an error.
+func FuzzConjugate(f *testing.F) {
+	// Short and tricky verbs: single-syllable stems, -c infinitives,
+	// consonant clusters, reflexives and near-misses.
+	for _, seed := range []string{
+		"", "ć", "c", "ać", "óc", "móc", "iść", "jść", "ciąć", "być", "mieć",
+		"lec", "biec", "strzyc", "żec", "rzec", "źć", "ść", "aść", "gryźć",
+		"siąść", "wziąć", "dać", "żyć", "ssać", "lać", "oć", "eć", "yć",
+		"się", "ać się", "myć się", "xóć", "e-mailować", "-ować", "ąć",
+		"Zażółcić", "  czytać  ", "czytać", "pisać\x00",
+	} {
+		f.Add(seed)
+	}
+	// Long runs of ambiguous prefixes (po+do / pod+o, z+o / zo) must not
+	// make prefix stripping blow up.
+	f.Add(strings.Repeat("podo", 20) + "ybywać")
+	f.Add(strings.Repeat("zo", 26) + "ystać")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		present, err := ConjugatePresent(s)
+		if (err == nil) == (len(present) == 0) {
+			t.Errorf("ConjugatePresent(%q) = %d paradigms, error %v", s, len(present), err)
+		}
+		past, err := ConjugatePast(s)
+		if (err == nil) == (len(past) == 0) {
+			t.Errorf("ConjugatePast(%q) = %d paradigms, error %v", s, len(past), err)
+		}
+		nouns, err := VerbalNoun(s)
+		if (err == nil) == (len(nouns) == 0) {
+			t.Errorf("VerbalNoun(%q) = %d forms, error %v", s, len(nouns), err)
+		}
+	})
+}
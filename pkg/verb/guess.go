@@ -0,0 +1,122 @@
+package verb
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ConjugatePresentGuess is ConjugatePresent for text the lookups and
+// heuristics were not written for, such as slang and neologisms. Where
+// ConjugatePresent fails with ErrNoMatch, it guesses a paradigm from the
+// verbs that share the infinitive's ending, marked Guessed and with Low
+// confidence. Any other result, errors for input that is not an
+// infinitive included, is ConjugatePresent's.
+func ConjugatePresentGuess(infinitive string) ([]Paradigm, error) {
+	paradigms, err := ConjugatePresent(infinitive)
+	if !errors.Is(err, ErrNoMatch) {
+		return paradigms, err
+	}
+	normalized, capital := normalizeInfinitive(infinitive)
+	base, reflexive := splitReflexive(normalized)
+	p, ok := guessPresent(base)
+	if !ok {
+		return nil, err
+	}
+	if reflexive {
+		p = p.withReflexive()
+	}
+	if capital {
+		p = p.capitalized()
+	}
+	return []Paradigm{{PresentTense: p, Confidence: Low, Guessed: true}}, nil
+}
+
+// guessModel is a lexicon verb with its present paradigm, a model for
+// guessing verbs that end the same way.
+type guessModel struct {
+	infinitive string
+	present    PresentTense
+}
+
+// guessModels conjugates the lexicon on first use.
+var guessModels = sync.OnceValue(func() []guessModel {
+	var models []guessModel
+	for _, e := range lexicon() {
+		if strings.Contains(e.infinitive, " ") {
+			continue
+		}
+		explained, err := conjugatePresentExplained(e.infinitive)
+		if err != nil {
+			continue
+		}
+		models = append(models, guessModel{e.infinitive, explained[0].PresentTense})
+	}
+	return models
+})
+
+// guessPresent conjugates an infinitive by analogy with the lexicon verbs
+// sharing its longest ending. Each model whose forms all keep the part of
+// the model before the ending votes for the endings that follow it, and
+// the pattern with the most votes wins: lajkować shares -kować with
+// pakować and malować, which give -kuję, so lajkuję. Models are only
+// taken from the longest ending that has any, so a rarer but closer
+// pattern beats a common distant one.
+func guessPresent(infinitive string) (PresentTense, bool) {
+	for n := utf8.RuneCountInString(infinitive) - 1; n > 0; n-- {
+		ending := lastRunes(infinitive, n)
+		stem := strings.TrimSuffix(infinitive, ending)
+		votes := make(map[PresentTense]int)
+		var best PresentTense
+		for _, m := range guessModels() {
+			pattern, ok := endingPattern(m, ending)
+			if !ok {
+				continue
+			}
+			votes[pattern]++
+			if votes[pattern] > votes[best] || votes[pattern] == votes[best] && pattern.Sg1 < best.Sg1 {
+				best = pattern
+			}
+		}
+		if len(votes) > 0 {
+			return PresentTense{
+				Sg1: stem + best.Sg1,
+				Sg2: stem + best.Sg2,
+				Sg3: stem + best.Sg3,
+				Pl1: stem + best.Pl1,
+				Pl2: stem + best.Pl2,
+				Pl3: stem + best.Pl3,
+			}, true
+		}
+	}
+	return PresentTense{}, false
+}
+
+// endingPattern returns the forms of a model with the part before the
+// ending stripped: pakować with ending kować gives kuję, kujesz... It
+// fails when the model does not end in the ending or a form changes the
+// part before it.
+func endingPattern(m guessModel, ending string) (PresentTense, bool) {
+	stem, ok := strings.CutSuffix(m.infinitive, ending)
+	if !ok || stem == "" {
+		return PresentTense{}, false
+	}
+	forms := []string{m.present.Sg1, m.present.Sg2, m.present.Sg3, m.present.Pl1, m.present.Pl2, m.present.Pl3}
+	for i, form := range forms {
+		if forms[i], ok = strings.CutPrefix(form, stem); !ok {
+			return PresentTense{}, false
+		}
+	}
+	return PresentTense{forms[0], forms[1], forms[2], forms[3], forms[4], forms[5]}, true
+}
+
+// lastRunes returns the last n runes of s.
+func lastRunes(s string, n int) string {
+	for i := range s {
+		if n == utf8.RuneCountInString(s[i:]) {
+			return s[i:]
+		}
+	}
+	return s
+}
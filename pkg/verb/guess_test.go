@@ -0,0 +1,59 @@
+package verb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGuessPresent(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       PresentTense
+	}{
+		// -ować neologism: the -uję pattern of pakować, malować...
+		{"lajkować", PresentTense{"lajkuję", "lajkujesz", "lajkuje", "lajkujemy", "lajkujecie", "lajkują"}},
+		// made-up -ić verb: the default -ię
+		{"szlampić", PresentTense{"szlampię", "szlampisz", "szlampi", "szlampimy", "szlampicie", "szlampią"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, ok := guessPresent(tt.infinitive)
+			if !ok || !got.Equals(tt.want) {
+				t.Errorf("guessPresent(%q) = %v, %v; want %v", tt.infinitive, got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestConjugatePresentGuess(t *testing.T) {
+	// A verb a heuristic matches is not a guess
+	paradigms, err := ConjugatePresentGuess("lajkować")
+	if err != nil {
+		t.Fatalf("ConjugatePresentGuess(lajkować) error: %v", err)
+	}
+	if p := paradigms[0]; p.Sg1 != "lajkuję" || p.Guessed || p.Confidence != Medium {
+		t.Errorf("ConjugatePresentGuess(lajkować) = %+v, want lajkuję, not guessed, medium", p)
+	}
+
+	// xóć matches no heuristic, so the strict form errors and the guess
+	// is marked
+	if _, err := ConjugatePresent("xóć"); !errors.Is(err, ErrNoMatch) {
+		t.Fatalf("ConjugatePresent(xóć) error = %v, want ErrNoMatch", err)
+	}
+	paradigms, err = ConjugatePresentGuess("Xóć się")
+	if err != nil {
+		t.Fatalf("ConjugatePresentGuess(Xóć się) error: %v", err)
+	}
+	if len(paradigms) != 1 {
+		t.Fatalf("ConjugatePresentGuess(Xóć się) returned %d paradigms, want 1", len(paradigms))
+	}
+	if p := paradigms[0]; !p.Guessed || p.Confidence != Low || p.Sg3 != "Xó się" {
+		t.Errorf("ConjugatePresentGuess(Xóć się) = %+v, want guessed, low, Xó się", p)
+	}
+
+	// Input that is not an infinitive is still rejected
+	if _, err := ConjugatePresentGuess("pies"); !errors.Is(err, ErrNotInfinitive) {
+		t.Errorf("ConjugatePresentGuess(pies) error = %v, want ErrNotInfinitive", err)
+	}
+}
@@ -0,0 +1,266 @@
+package verb
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// ImperativeParadigm holds the three imperative forms of a verb.
+type ImperativeParadigm struct {
+	Sg2   string `json:"sg2"`             // ty - czytaj
+	Pl1   string `json:"pl1"`             // my - czytajmy
+	Pl2   string `json:"pl2"`             // wy - czytajcie
+	Gloss string `json:"gloss,omitempty"` // e.g., "to stand" (empty for non-homographs)
+}
+
+// irregularImperatives lists 2sg imperatives that cannot be read off the
+// present tense. Prefixed forms are found by prefix stripping:
+// zjeść → zjedz, powiedzieć → powiedz, zdobyć → zdobądź.
+var irregularImperatives = map[string]string{
+	"być":      "bądź",
+	"mieć":     "miej",
+	"jeść":     "jedz",
+	"wiedzieć": "wiedz",
+	"wziąć":    "weź",
+	"chcieć":   "chciej",
+	"jść":      "jdź", // prefixed iść: przyjść → przyjdź, wejść → wejdź
+}
+
+// imperativeSoftening maps the consonant before the -i of a present 3sg to
+// its soft word-final spelling: prosi → proś, chodzi → chodź, płaci → płać.
+// Labials and l drop the softness (robi → rób, chwali → chwal), so they are
+// not listed. Longest keys first.
+var imperativeSoftening = []struct{ hard, soft string }{
+	{"dz", "dź"}, {"s", "ś"}, {"z", "ź"}, {"c", "ć"}, {"n", "ń"},
+}
+
+// imperativeLengtheningConsonants are the word-final consonants before which
+// o lengthens to ó in the imperative: rób, mów, wóź, twórz, pozwól, krój,
+// stój, bój się. Voiceless consonants (noś, koś) and ń (dzwoń) do not
+// lengthen, nor does dź outside lengthenedDzVerbs: rodź, chodź.
+var imperativeLengtheningConsonants = []string{
+	"rz", "b", "d", "g", "w", "z", "ź", "ż", "l", "ł", "j",
+}
+
+// lengthenedDzVerbs are the -odzić verbs whose imperative does take ó
+// before dź, with their prefixed forms: wódź, dowódź, pogódź się.
+var lengthenedDzVerbs = []string{"wodzić", "godzić"}
+
+// ErrNoImperative is returned by Imperative for the modals móc and
+// musieć, which have no imperative (not *móż, *muś), and for archaic -c
+// verbs whose present stem is only guessed.
+var ErrNoImperative = errors.New("verb has no imperative")
+
+// noImperativeVerbs are the verbs Imperative rejects with ErrNoImperative.
+// Prefixed forms are not modals and keep theirs: pomóż.
+var noImperativeVerbs = map[string]bool{
+	"móc": true, "musieć": true,
+}
+
+// unknownStemCVerbs are archaic -c verbs whose velar the -c infinitive
+// hides: the present guess lekę for lec (really legnę) would give lecz,
+// the imperative of leczyć. Their prefixed forms are rejected too unless
+// an irregular entry knows the stem (ulec → ulegnij); wlec is a verb of
+// its own.
+var unknownStemCVerbs = []string{"lec", "żec"}
+
+// hasUnknownCStem reports whether infinitive is a verb in
+// unknownStemCVerbs, possibly prefixed, with no irregular present.
+func hasUnknownCStem(infinitive string) bool {
+	if strings.HasSuffix(infinitive, "wlec") {
+		return false
+	}
+	if _, _, ok := lookupIrregularPresent(infinitive); ok {
+		return false
+	}
+	for _, base := range unknownStemCVerbs {
+		if prefix, ok := strings.CutSuffix(infinitive, base); ok && canStripPrefixes(prefix, verbPrefixes) {
+			return true
+		}
+	}
+	return false
+}
+
+// Imperative returns the imperative paradigms of a verb (2sg, 1pl, 2pl).
+// Most verbs return a single paradigm; present tense homographs return one
+// per reading (stać → stój, stań).
+//
+// The 2sg is derived from the present tense:
+//   - -am/-em verbs add -j to the 3sg: czyta → czytaj, umie → umiej
+//   - -awać verbs keep -awa-: dawać → dawaj
+//   - other verbs drop the 3sg ending, soften the final consonant and add
+//     -ij/-yj after clusters: robi → rób, niesie → nieś, ciągnie → ciągnij,
+//     śpi → śpij, trze → trzyj
+//
+// The plural is built on the 2sg, so it keeps the same vowel: rób, róbmy,
+// róbcie. The modals móc and musieć return ErrNoImperative, as do
+// archaic -c verbs such as lec whose present stem is unknown.
+func Imperative(infinitive string) ([]ImperativeParadigm, error) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	if base, _ := splitReflexive(infinitive); noImperativeVerbs[base] {
+		return nil, fmt.Errorf("%q: %w", infinitive, ErrNoImperative)
+	}
+	if base, ok := splitReflexive(infinitive); ok {
+		paradigms, err := Imperative(base)
+		if err != nil {
+			return nil, err
+		}
+		for i, p := range paradigms {
+			paradigms[i] = ImperativeParadigm{
+				Sg2:   p.Sg2 + reflexiveParticle,
+				Pl1:   p.Pl1 + reflexiveParticle,
+				Pl2:   p.Pl2 + reflexiveParticle,
+				Gloss: p.Gloss,
+			}
+		}
+		return paradigms, nil
+	}
+
+	if sg2, ok := lookupIrregularImperative(infinitive); ok {
+		return []ImperativeParadigm{buildImperative(sg2, "")}, nil
+	}
+	if hasUnknownCStem(infinitive) {
+		return nil, fmt.Errorf("%q: %w", infinitive, ErrNoImperative)
+	}
+
+	present, err := ConjugatePresent(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	var paradigms []ImperativeParadigm
+	for _, p := range present {
+		sg2, ok := imperativeFromPresent(infinitive, p.PresentTense)
+		if !ok {
+			return nil, &ConjugationError{Infinitive: infinitive, Form: "imperative"}
+		}
+		paradigms = append(paradigms, buildImperative(sg2, p.Gloss))
+	}
+	return paradigms, nil
+}
+
+// buildImperative forms the plural persons from the 2sg.
+func buildImperative(sg2, gloss string) ImperativeParadigm {
+	return ImperativeParadigm{Sg2: sg2, Pl1: sg2 + "my", Pl2: sg2 + "cie", Gloss: gloss}
+}
+
+// lookupIrregularImperative looks up an irregular 2sg imperative, stripping
+// one or more prefixes: odpowiedzieć (od+po) → odpowiedz.
+func lookupIrregularImperative(infinitive string) (string, bool) {
+	for base, sg2 := range irregularImperatives {
+		prefix, ok := strings.CutSuffix(infinitive, base)
+		if ok && canStripPrefixes(prefix, verbPrefixes) {
+			return prefix + sg2, true
+		}
+	}
+	return "", false
+}
+
+// imperativeFromPresent derives the 2sg imperative from a present paradigm.
+func imperativeFromPresent(infinitive string, p PresentTense) (string, bool) {
+	switch {
+	case strings.HasSuffix(infinitive, "awać") && strings.HasSuffix(p.Sg1, "aję"):
+		// dawać → dawaj (not *daj), wstawać → wstawaj
+		return strings.TrimSuffix(infinitive, "ć") + "j", true
+	case strings.HasSuffix(p.Sg1, "m"):
+		// czyta → czytaj, umie → umiej, da → daj
+		return p.Sg3 + "j", true
+	case !strings.HasSuffix(p.Sg1, "ę"):
+		return "", false
+	}
+
+	var sg2 string
+	if stem, ok := strings.CutSuffix(p.Sg3, "i"); ok {
+		sg2 = imperativeSoftStem(stem) // robi, śpi
+	} else if stem, ok := strings.CutSuffix(p.Sg3, "ie"); ok {
+		sg2 = imperativeSoftStem(stem) // niesie, ciągnie, rwie
+	} else if stem, ok := strings.CutSuffix(p.Sg3, "y"); ok {
+		sg2 = stem // uczy → ucz, leży → leż
+	} else if stem, ok := strings.CutSuffix(p.Sg3, "e"); ok {
+		sg2 = imperativeHardStem(stem) // pisze, pije, trze
+	} else {
+		return "", false
+	}
+
+	if stem, ok := strings.CutSuffix(sg2, "odź"); ok && slices.ContainsFunc(lengthenedDzVerbs, func(v string) bool {
+		return strings.HasSuffix(infinitive, v)
+	}) {
+		return stem + "ódź", true
+	}
+	return lengthenImperativeO(sg2), true
+}
+
+// imperativeSoftStem finishes a stem whose 3sg ends in soft -i/-ie:
+// final softening (nies → nieś, chodz → chodź), -j after a vowel
+// (stro → stroj), and -ij after a vowelless root or consonant + n
+// (śp → śpij, pełn → pełnij, ciągn → ciągnij, but czern → czerń).
+func imperativeSoftStem(stem string) string {
+	last, _ := utf8.DecodeLastRuneInString(stem)
+	if isPolishVowel(last) {
+		return stem + "j"
+	}
+	if !rootHasVowel(stem) {
+		return stem + "ij"
+	}
+	if before, ok := strings.CutSuffix(stem, "n"); ok {
+		prev, _ := utf8.DecodeLastRuneInString(before)
+		if !isPolishVowel(prev) && prev != 'r' {
+			return stem + "ij"
+		}
+	}
+	for _, s := range imperativeSoftening {
+		if before, ok := strings.CutSuffix(stem, s.hard); ok {
+			return before + s.soft
+		}
+	}
+	return stem
+}
+
+// imperativeHardStem finishes a stem whose 3sg ends in hard -e: the stem
+// is the imperative (pisz, pij, bierz), except that consonant + r/rz takes
+// -yj (trz → trzyj, zetrz → zetrzyj, żr → żryj).
+func imperativeHardStem(stem string) string {
+	for _, r := range []string{"rz", "r"} {
+		if before, ok := strings.CutSuffix(stem, r); ok && before != "" {
+			prev, _ := utf8.DecodeLastRuneInString(before)
+			if !isPolishVowel(prev) {
+				return stem + "yj"
+			}
+			break
+		}
+	}
+	return stem
+}
+
+// rootHasVowel reports whether a present stem's root, after any single
+// prefix, contains a vowel: porw (po+rw) and zatn (za+tn) do not.
+func rootHasVowel(stem string) bool {
+	if !containsVowel(stem) {
+		return false
+	}
+	for _, p := range verbPrefixes {
+		if rest, ok := strings.CutPrefix(stem, p); ok && rest != "" && !containsVowel(rest) {
+			return false
+		}
+	}
+	return true
+}
+
+// lengthenImperativeO turns a final o + voiced consonant into ó + consonant
+// after a consonant: rob → rób, twórz, pozwól, strój (but zaorz). See
+// imperativeLengtheningConsonants for the consonants.
+func lengthenImperativeO(form string) string {
+	for _, c := range imperativeLengtheningConsonants {
+		before, ok := strings.CutSuffix(form, "o"+c)
+		if !ok || before == "" {
+			continue
+		}
+		if prev, _ := utf8.DecodeLastRuneInString(before); isPolishVowel(prev) {
+			return form
+		}
+		return before + "ó" + c
+	}
+	return form
+}
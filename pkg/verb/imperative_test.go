@@ -0,0 +1,124 @@
+package verb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestImperative(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg2    string
+		wantPl1    string
+		wantPl2    string
+	}{
+		// -am/-em: 3sg + j
+		{"czytać", "czytaj", "czytajmy", "czytajcie"},
+		{"umieć", "umiej", "umiejmy", "umiejcie"},
+		{"dawać", "dawaj", "dawajmy", "dawajcie"}, // -awać keeps -awa-
+		// -ić/-yć: soft stem, o → ó before a voiced consonant
+		{"robić", "rób", "róbmy", "róbcie"},
+		{"zrobić", "zrób", "zróbmy", "zróbcie"},
+		{"chodzić", "chodź", "chodźmy", "chodźcie"}, // dz → dź, no ó
+		{"rodzić", "rodź", "rodźmy", "rodźcie"},
+		{"urodzić", "urodź", "urodźmy", "urodźcie"},
+		{"wodzić", "wódź", "wódźmy", "wódźcie"}, // the -odzić verbs that lengthen
+		{"dowodzić", "dowódź", "dowódźmy", "dowódźcie"},
+		{"pogodzić się", "pogódź się", "pogódźmy się", "pogódźcie się"},
+		{"przychodzić", "przychodź", "przychodźmy", "przychodźcie"},
+		{"prosić", "proś", "prośmy", "proście"}, // s → ś, voiceless: no ó
+		{"nosić", "noś", "nośmy", "noście"},
+		{"mówić", "mów", "mówmy", "mówcie"},
+		{"wozić", "wóź", "wóźmy", "wóźcie"},
+		{"płacić", "płać", "płaćmy", "płaćcie"},
+		{"dzwonić", "dzwoń", "dzwońmy", "dzwońcie"}, // no ó before ń
+		{"pozwolić", "pozwól", "pozwólmy", "pozwólcie"},
+		{"stroić", "strój", "strójmy", "strójcie"}, // vowel stem takes -j
+		{"czcić", "czcij", "czcijmy", "czcijcie"},  // no vowel: -ij
+		{"pełnić", "pełnij", "pełnijmy", "pełnijcie"},
+		{"uczyć", "ucz", "uczmy", "uczcie"},
+		{"tworzyć", "twórz", "twórzmy", "twórzcie"},
+		{"położyć", "połóż", "połóżmy", "połóżcie"},
+		// -eć
+		{"widzieć", "widź", "widźmy", "widźcie"},
+		{"leżeć", "leż", "leżmy", "leżcie"},
+		// -ę/-esz
+		{"pisać", "pisz", "piszmy", "piszcie"},
+		{"nieść", "nieś", "nieśmy", "nieście"},
+		{"pić", "pij", "pijmy", "pijcie"},
+		{"pomóc", "pomóż", "pomóżmy", "pomóżcie"},
+		{"trzeć", "trzyj", "trzyjmy", "trzyjcie"}, // cluster + rz takes -yj
+		// -ij after clusters
+		{"spać", "śpij", "śpijmy", "śpijcie"},
+		{"ciągnąć", "ciągnij", "ciągnijmy", "ciągnijcie"},
+		{"zacząć", "zacznij", "zacznijmy", "zacznijcie"},
+		{"porwać", "porwij", "porwijmy", "porwijcie"},
+		{"minąć", "miń", "mińmy", "mińcie"}, // vowel + n: no -ij
+		// irregular
+		{"być", "bądź", "bądźmy", "bądźcie"},
+		{"mieć", "miej", "miejmy", "miejcie"},
+		{"jeść", "jedz", "jedzmy", "jedzcie"},
+		{"zjeść", "zjedz", "zjedzmy", "zjedzcie"},
+		{"wziąć", "weź", "weźmy", "weźcie"},
+		{"odpowiedzieć", "odpowiedz", "odpowiedzmy", "odpowiedzcie"},
+		{"przyjść", "przyjdź", "przyjdźmy", "przyjdźcie"},
+		// reflexive
+		{"bać się", "bój się", "bójmy się", "bójcie się"},
+		{"stać", "stój", "stójmy", "stójcie"},
+		{"kroić", "krój", "krójmy", "krójcie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			paradigms, err := Imperative(tt.infinitive)
+			if err != nil {
+				t.Fatalf("Imperative(%q) error: %v", tt.infinitive, err)
+			}
+			got := paradigms[0]
+			if got.Sg2 != tt.wantSg2 || got.Pl1 != tt.wantPl1 || got.Pl2 != tt.wantPl2 {
+				t.Errorf("Imperative(%q) = %s, %s, %s; want %s, %s, %s",
+					tt.infinitive, got.Sg2, got.Pl1, got.Pl2, tt.wantSg2, tt.wantPl1, tt.wantPl2)
+			}
+		})
+	}
+}
+
+func TestImperativeHomograph(t *testing.T) {
+	paradigms, err := Imperative("stać")
+	if err != nil {
+		t.Fatalf("Imperative error: %v", err)
+	}
+	if len(paradigms) != 2 || paradigms[0].Sg2 != "stój" || paradigms[1].Sg2 != "stań" {
+		t.Errorf("Imperative(stać) = %+v, want stój and stań", paradigms)
+	}
+}
+
+func TestImperativeModals(t *testing.T) {
+	for _, infinitive := range []string{"móc", "musieć", "Móc"} {
+		if got, err := Imperative(infinitive); !errors.Is(err, ErrNoImperative) {
+			t.Errorf("Imperative(%q) = %+v, %v; want ErrNoImperative", infinitive, got, err)
+		}
+	}
+	// A prefixed móc is not a modal
+	paradigms, err := Imperative("pomóc")
+	if err != nil || paradigms[0].Sg2 != "pomóż" {
+		t.Errorf("Imperative(pomóc) = %+v, %v; want pomóż", paradigms, err)
+	}
+}
+
+func TestImperativeUnknownCStem(t *testing.T) {
+	for _, infinitive := range []string{"lec", "polec", "żec", "lec się"} {
+		if got, err := Imperative(infinitive); !errors.Is(err, ErrNoImperative) {
+			t.Errorf("Imperative(%q) = %+v, %v; want ErrNoImperative", infinitive, got, err)
+		}
+	}
+	// An irregular entry or a different verb keeps the imperative
+	for infinitive, want := range map[string]string{
+		"ulec": "ulegnij", "wlec": "wlecz", "przywlec": "przywlecz", "piec": "piecz",
+	} {
+		paradigms, err := Imperative(infinitive)
+		if err != nil || paradigms[0].Sg2 != want {
+			t.Errorf("Imperative(%q) = %+v, %v; want %s", infinitive, paradigms, err, want)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package verb
 
+import "slices"
+
 // Conjugation classes for Polish present tense.
 // Named after standard Polish linguistics conventions.
 const (
@@ -55,7 +57,10 @@ func (s presentSpec) build() PresentTense {
 	case ConjIV:
 		pt = PresentTense{
 			Sg1: s.stem + "m", Sg2: s.stem + "sz", Sg3: s.stem,
-			Pl1: s.stem + "my", Pl2: s.stem + "cie", Pl3: sg13 + "ją",
+			Pl1: s.stem + "my", Pl2: s.stem + "cie", Pl3: s.stem + "ją",
+		}
+		if s.sg13 != "" {
+			pt.Pl3 = s.sg13 + "ą" // jedzą, wiedzą
 		}
 	}
 
@@ -204,24 +209,80 @@ var homographs = map[string][]Paradigm{
 			Gloss:        "to talk back (variant)",
 		},
 	},
+	// -otać verbs with both t→cz and regular -am paradigms attested
+	"chichotać": {
+		{
+			PresentTense: presentSpec{stem: "chichocz", class: ConjI}.build(),
+			Gloss:        "to giggle",
+		},
+		{
+			PresentTense: presentSpec{stem: "chichot", class: ConjIII}.build(),
+			Gloss:        "to giggle (variant)",
+		},
+	},
+	"bełkotać": {
+		{
+			PresentTense: presentSpec{stem: "bełkocz", class: ConjI}.build(),
+			Gloss:        "to mumble",
+		},
+		{
+			PresentTense: presentSpec{stem: "bełkot", class: ConjIII}.build(),
+			Gloss:        "to mumble (variant)",
+		},
+	},
+	"trzepotać": {
+		{
+			PresentTense: presentSpec{stem: "trzepocz", class: ConjI}.build(),
+			Gloss:        "to flutter",
+		},
+		{
+			PresentTense: presentSpec{stem: "trzepot", class: ConjIII}.build(),
+			Gloss:        "to flutter (variant)",
+		},
+	},
+	"łopotać": {
+		{
+			PresentTense: presentSpec{stem: "łopocz", class: ConjI}.build(),
+			Gloss:        "to flap",
+		},
+		{
+			PresentTense: presentSpec{stem: "łopot", class: ConjIII}.build(),
+			Gloss:        "to flap (variant)",
+		},
+	},
+	"łaskotać": {
+		{
+			PresentTense: presentSpec{stem: "łaskocz", class: ConjI}.build(),
+			Gloss:        "to tickle",
+		},
+		{
+			PresentTense: presentSpec{stem: "łaskot", class: ConjIII}.build(),
+			Gloss:        "to tickle (variant)",
+		},
+	},
 }
 
-// lookupHomograph returns all paradigms for a homograph verb.
-func lookupHomograph(infinitive string) ([]Paradigm, bool) {
+// expandableHomographs lists the homographs that support prefix expansion
+// because their prefixed forms retain both conjugation patterns.
+// stać prefixed forms like "dostać" are NOT homographs.
+// These play the role of prefixableVerbs for homographs.
+var expandableHomographs = map[string]bool{
+	"słać": true, "chlać": true, "ziajać": true, "bajać": true,
+	"przytajać": true, "kaszliwać": true, "pyskiwać": true,
+	"chichotać": true, "bełkotać": true, "trzepotać": true,
+	"łopotać": true, "łaskotać": true,
+}
+
+// lookupHomograph returns all paradigms for a homograph verb, and the
+// prefix stripped to find it (empty for a direct match).
+func lookupHomograph(infinitive string) ([]Paradigm, string, bool) {
 	// Direct lookup - only the bare form, not prefixed forms
 	// Prefixed forms like "dostać", "przestać" are NOT homographs:
 	// they only use one paradigm (stanę), handled by heuristics.
 	if paradigms, ok := homographs[infinitive]; ok {
-		return paradigms, true
+		return slices.Clone(paradigms), "", true
 	}
 
-	// These homographs support prefix expansion because their
-	// prefixed forms retain both conjugation patterns.
-	// stać prefixed forms like "dostać" are NOT homographs.
-	expandableHomographs := map[string]bool{
-		"słać": true, "chlać": true, "ziajać": true, "bajać": true,
-		"przytajać": true, "kaszliwać": true, "pyskiwać": true,
-	}
 	for _, prefix := range verbPrefixes {
 		if len(infinitive) > len(prefix) && infinitive[:len(prefix)] == prefix {
 			base := infinitive[len(prefix):]
@@ -243,13 +304,13 @@ func lookupHomograph(infinitive string) ([]Paradigm, bool) {
 							Gloss: bp.Gloss,
 						}
 					}
-					return result, true
+					return result, prefix, true
 				}
 			}
 		}
 	}
 
-	return nil, false
+	return nil, "", false
 }
 
 // irregularPresentSpecs defines present tense stems compactly.
@@ -278,31 +339,12 @@ var irregularPresentSpecs = map[string]presentSpec{
 	"brać": {sg13: "bior", stem: "bierz", class: ConjI},
 	"prać": {sg13: "pior", stem: "pierz", class: ConjI},
 
-	// Minority -sać verbs that alternate (s→sz)
-	"pisać":   {stem: "pisz", class: ConjI},
-	"czesać":  {stem: "czesz", class: ConjI},
-	"kołysać": {stem: "kołysz", class: ConjI},
-
 	// -sać verbs that stay regular -am
 	"kasać":   {stem: "kas", class: ConjIII},
 	"ciosać":  {stem: "cios", class: ConjIII},
 	"ciesać":  {stem: "cies", class: ConjIII},
 	"krzesać": {stem: "krzes", class: ConjIII},
 
-	// Minority -kać verbs that alternate (k→cz)
-	"skakać":  {stem: "skacz", class: ConjI},
-	"płakać":  {stem: "płacz", class: ConjI},
-
-	// Minority -zać verbs that alternate (z→ż)
-	"wiązać":  {stem: "wiąż", class: ConjI},
-	"kazać":   {stem: "każ", class: ConjI},
-	"mazać":   {stem: "maż", class: ConjI},
-	"lizać":   {stem: "liż", class: ConjI},
-	"okazać":  {stem: "okaż", class: ConjI},
-
-	// Minority -rać that alternates (r→rz)
-	"karać":   {stem: "karz", class: ConjI},
-
 	// naleźć - suppletive stem najd-
 	"naleźć":  {sg13: "najd", stem: "najdzi", class: ConjI},
 
@@ -352,8 +394,8 @@ var irregularPresentSpecs = map[string]presentSpec{
 	// ciec verbs: k-insertion
 	"ciec":      {sg13: "ciekn", stem: "ciekni", class: ConjI},
 
-	// woleć
-	"woleć":     {stem: "wol", class: ConjIIa},
+	// rość: present of its variant rosnąć, n-insertion and s→ś
+	"rość":      {sg13: "rosn", stem: "rośni", class: ConjI},
 
 	// -jąć verbs: suppletive stem -jm-
 	"jąć":    {sg13: "jm", stem: "jmi", class: ConjI},
@@ -366,29 +408,14 @@ var irregularPresentSpecs = map[string]presentSpec{
 	// -cząć verbs: czn- stem
 	"cząć":      {sg13: "czn", stem: "czni", class: ConjI},
 	"począć":    {sg13: "poczn", stem: "poczni", class: ConjI},
-	"odpocząć":  {sg13: "odpoczn", stem: "odpoczni", class: ConjI},
-	"rozpocząć": {sg13: "rozpoczn", stem: "rozpoczni", class: ConjI},
-	"spocząć":   {sg13: "spoczn", stem: "spoczni", class: ConjI},
-	"wypocząć":  {sg13: "wypoczn", stem: "wypoczni", class: ConjI},
 	"wszcząć":   {sg13: "wszczn", stem: "wszczni", class: ConjI},
 	"poczęć":    {sg13: "poczn", stem: "poczni", class: ConjI},
 
 	// Action verb -mieć patterns (grzmieć → grzmię, not grzmieję)
-	"grzmieć":  {sg13: "grzmi", stem: "grzm", class: ConjIIa},
-	"szumieć":  {sg13: "szumi", stem: "szum", class: ConjIIa},
-	"tłumieć":  {sg13: "tłumi", stem: "tłum", class: ConjIIa},
 
 	// patrzeć - action verb (class y)
-	"patrzeć":  {stem: "patrz", class: ConjIIb},
 
 	// Inchoative -rzeć/-eć verbs (use -eję pattern)
-	"starzeć":  {stem: "starzej", class: ConjI},
-	"gorzeć":   {stem: "gorzej", class: ConjI},
-	"dorzeć":   {stem: "dorzej", class: ConjI},
-	"dobrzeć":  {stem: "dobrzej", class: ConjI},
-	"dojrzeć":  {stem: "dojrzej", class: ConjI},
-	"doźrzeć":  {stem: "doźrzej", class: ConjI},
-	"przejrzeć": {stem: "przejrzej", class: ConjI},
 
 	// -rwać verbs: -ę/-ie pattern
 	"rwać":      {sg13: "rw", stem: "rwi", class: ConjI},
@@ -403,16 +430,10 @@ var irregularPresentSpecs = map[string]presentSpec{
 	"śmiać":     {stem: "śmiej", class: ConjI},
 
 	// -ieć action verbs
-	"cierpieć":   {sg13: "cierpi", stem: "cierp", class: ConjIIa},
 	"wisieć":     {sg13: "wisz", stem: "wis", class: ConjIIa},
-	"tkwieć":     {sg13: "tkwi", stem: "tkw", class: ConjIIa},
-	"śmierdzieć": {stem: "śmierdz", class: ConjIIa},
-	"swędzieć":   {stem: "swędz", class: ConjIIa},
-	"pierdzieć":  {stem: "pierdz", class: ConjIIa},
-	"skomleć":    {stem: "skoml", class: ConjIIa},
 
 	// jeździć - correct softening źdź → żdż
-	"jeździć":    {sg13: "jeżdż", stem: "jeźdź", class: ConjIIa},
+	"jeździć":    {sg13: "jeżdż", stem: "jeźdz", class: ConjIIa},
 
 	// -nieć action verbs
 	"pachnieć":   {sg13: "pachn", stem: "pachni", class: ConjI},
@@ -420,15 +441,6 @@ var irregularPresentSpecs = map[string]presentSpec{
 	// -strzec verbs: c→g alternation
 	"strzec":     {sg13: "strzeg", stem: "strzeż", class: ConjI},
 
-	// -chować verbs: -owam
-	"chować":     {stem: "chow", class: ConjIII},
-
-	// -kraść verbs: suppletive kradn- stem
-	"kraść":      {sg13: "kradn", stem: "kradni", class: ConjI},
-
-	// -kłaść verbs: suppletive kład- stem
-	"kłaść":      {sg13: "kład", stem: "kładzi", class: ConjI},
-
 	// uczcić/czcić - needs szcz
 	"uczcić":     {sg13: "uczcz", stem: "uczc", class: ConjIIa},
 	"czcić":      {sg13: "czcz", stem: "czc", class: ConjIIa},
@@ -457,17 +469,6 @@ var irregularPresentSpecs = map[string]presentSpec{
 	// wspomnieć - special prefix form
 	"wspomnieć":   {stem: "wspomn", class: ConjIIa},
 
-	// opisać - minority alternating -sać
-	"opisać":      {stem: "opisz", class: ConjI},
-
-	// wskazać - minority alternating -zać
-	"wskazać":     {stem: "wskaż", class: ConjI},
-
-	// brać prefix verbs with vowel elision
-	"odebrać":  {sg13: "odbior", stem: "odbierz", class: ConjI},
-	"zebrać":   {sg13: "zbior", stem: "zbierz", class: ConjI},
-	"rozebrać": {sg13: "rozbior", stem: "rozbierz", class: ConjI},
-
 	// lać verbs (j-insertion)
 	"lać":       {stem: "lej", class: ConjI},
 
@@ -499,15 +500,6 @@ var irregularPresentSpecs = map[string]presentSpec{
 	// piąć - suppletive stem pn
 	"piąć":      {sg13: "pn", stem: "pni", class: ConjI},
 	"wspiąć":    {sg13: "wespn", stem: "wespni", class: ConjI},
-	"zapiąć":    {sg13: "zapn", stem: "zapni", class: ConjI},
-	"przypiąć":  {sg13: "przypn", stem: "przypni", class: ConjI},
-	"odpiąć":    {sg13: "odpn", stem: "odpni", class: ConjI},
-	"dopiąć":    {sg13: "dopn", stem: "dopni", class: ConjI},
-	"spiąć":     {sg13: "spn", stem: "spni", class: ConjI},
-	"wpiąć":     {sg13: "wpn", stem: "wpni", class: ConjI},
-	"napiąć":    {sg13: "napn", stem: "napni", class: ConjI},
-	"rozpiąć":   {sg13: "rozpn", stem: "rozpni", class: ConjI},
-	"wypiąć":    {sg13: "wypn", stem: "wypni", class: ConjI},
 
 	// wiać - special pattern (wieję)
 	"wiać":      {stem: "wiej", class: ConjI},
@@ -524,6 +516,9 @@ var irregularPresentSpecs = map[string]presentSpec{
 	// ćpać - regular -am
 	"ćpać":      {stem: "ćp", class: ConjIII},
 
+	// motać - regular -am despite the -otać ending
+	"motać":     {stem: "mot", class: ConjIII},
+
 	// Regular -am -bać verbs (not alternating)
 	"bimbać":    {stem: "bimb", class: ConjIII},
 	"gabać":     {stem: "gab", class: ConjIII},
@@ -547,28 +542,6 @@ var irregularPresentSpecs = map[string]presentSpec{
 	"bombać":    {stem: "bomb", class: ConjIII},
 
 	// Inchoative -eć verbs (use -eję pattern)
-	"chorzeć":     {stem: "chorzej", class: ConjI},
-	"tężeć":       {stem: "tężej", class: ConjI},
-	"dumieć":      {stem: "dumiej", class: ConjI},
-	"goreć":       {stem: "gorej", class: ConjI},
-	"śniedzieć":   {stem: "śniedziej", class: ConjI},
-	"srebrzeć":    {stem: "srebrzej", class: ConjI},
-	"cukrzeć":     {stem: "cukrzej", class: ConjI},
-	"dorośleć":    {stem: "doroślej", class: ConjI},
-	"wydorośleć":  {stem: "wydoroślej", class: ConjI},
-	"zelżeć":      {stem: "zelżej", class: ConjI},
-	"wilżeć":      {stem: "wilżej", class: ConjI},
-	"wężeć":       {stem: "wężej", class: ConjI},
-	"rzedzieć":    {stem: "rzedziej", class: ConjI},
-	"sfolżeć":     {stem: "sfolżej", class: ConjI},
-	"szlachcieć":  {stem: "szlachciej", class: ConjI},
-	"ochujeć":     {stem: "ochujej", class: ConjI},
-	"ociężeć":     {stem: "ociężej", class: ConjI},
-	"ściężeć":     {stem: "ściężej", class: ConjI},
-	"oszedzieć":   {stem: "oszedziej", class: ConjI},
-	"szedzieć":    {stem: "szedziej", class: ConjI},
-	"sposążeć":    {stem: "sposążej", class: ConjI},
-	"wyryżeć":     {stem: "wyryżej", class: ConjI},
 
 	// siać - to sow (ia → ie + ję)
 	"siać":        {stem: "siej", class: ConjI},
@@ -595,6 +568,9 @@ var irregularPresentSpecs = map[string]presentSpec{
 	"clić":        {stem: "cl", class: ConjIIa},
 	"dlić":        {stem: "dl", class: ConjIIa},
 
+	// podlić is po + dlić; longest-prefix stripping would read pod + lić
+	"podlić":      {stem: "podl", class: ConjIIa},
+
 	// -ywać verbs that use -uję pattern
 	"mieszywać":   {stem: "mieszuj", class: ConjI},
 	"supływać":    {stem: "supłuj", class: ConjI},
@@ -617,8 +593,6 @@ var irregularPresentSpecs = map[string]presentSpec{
 	"sposzyć":     {stem: "sposzyj", class: ConjI},
 
 	// źreć/źrzeć - inchoative
-	"źreć":        {stem: "źrej", class: ConjI},
-	"źrzeć":       {stem: "źrzej", class: ConjI},
 
 	// oziać - uses -eję pattern
 	"oziać":       {stem: "oziej", class: ConjI},
@@ -628,8 +602,11 @@ var irregularPresentSpecs = map[string]presentSpec{
 	"powystrzeliwać":  {stem: "powystrzeliwuj", class: ConjI},
 }
 
-// Common prefixes in Polish
+// Common prefixes in Polish, longest first so that prefix stripping
+// prefers roze- over roz- and ode- over od-.
 var verbPrefixes = []string{
-	"prze", "przy", "roz", "roze", "wy", "za", "na", "po", "do", "od", "ode", "ob", "obe",
-	"pod", "pode", "nad", "nade", "wz", "wze", "u", "s", "z", "ze", "w", "we", "o",
+	"prze", "przy", "roze", "pode", "nade",
+	"roz", "ode", "obe", "pod", "nad", "wze",
+	"wy", "za", "na", "po", "do", "od", "ob", "wz", "ze", "we",
+	"u", "s", "z", "w", "o",
 }
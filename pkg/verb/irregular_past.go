@@ -2,6 +2,7 @@ package verb
 
 import (
 	"cmp"
+	"slices"
 	"strings"
 )
 
@@ -65,7 +66,23 @@ func buildWlecHomograph(prefix string) []PastParadigm {
 	}
 }
 
+// buildTworzycHomograph creates homograph entries for the -tworzyć verbs
+// with a twar- past (otworzyć, przetworzyć, roztworzyć). Their past uses the stem twar-
+// (otwarł), and sg3m also has the regular variant otworzył; the present stays
+// regular (otworzę). Other -tworzyć verbs (stworzyć, zatworzyć) are regular.
+func buildTworzycHomograph(prefix string) []PastParadigm {
+	return []PastParadigm{
+		{PastTense: pastSpec{stem: prefix + "twar"}.build(), Gloss: "sg3m twarł variant"},
+		{PastTense: pastSpec{stem: prefix + "twar", sg3m: prefix + "tworzył"}.build(), Gloss: "sg3m tworzył variant"},
+	}
+}
+
 func init() {
+	// Add homographs for the twar- past of otworzyć, przetworzyć, roztworzyć
+	for _, p := range []string{"o", "prze", "roz"} {
+		pastHomographs[p+"tworzyć"] = buildTworzycHomograph(p)
+	}
+
 	// Add homographs for prefixed -paść verbs
 	pascPrefixes := []string{"do", "na", "od", "o", "pod", "po", "prze", "przy", "roz", "s", "u", "w", "wy", "za", "zaprze"}
 	for _, p := range pascPrefixes {
@@ -83,7 +100,7 @@ func init() {
 // lookupPastHomograph returns all paradigms for a past tense homograph verb.
 func lookupPastHomograph(infinitive string) ([]PastParadigm, bool) {
 	if paradigms, ok := pastHomographs[infinitive]; ok {
-		return paradigms, true
+		return slices.Clone(paradigms), true
 	}
 	return nil, false
 }
@@ -246,9 +263,6 @@ var irregularPastSpecs = map[string]pastSpec{
 	// grześć → grzebł/grzebła (suppletive stem grzeb-)
 	"grześć": {stem: "grzeb"},
 
-	// tłuc → tłukł/tłukła
-	"tłuc": {stem: "tłuk"},
-
 	// przeć → parł/parła (suppletive stem par-)
 	"przeć": {stem: "par"},
 
@@ -280,10 +294,9 @@ var irregularPastSpecs = map[string]pastSpec{
 	"rozżec": {stem: "rozeżg", sg3m: "rozżegł"},
 	"zżec":   {stem: "zeżg", sg3m: "zżegł"},
 
-	// Compound prefixed verbs that need explicit entries
-	"spostrzec":  {stem: "spostrzeg"},
-	"zapobiec":   {stem: "zapobieg"},
-	"współubiec": {stem: "współubieg"},
+	// spostrzec: the present heuristic misses the g (spostrzekę), so the past
+	// cannot read it off the present
+	"spostrzec": {stem: "spostrzeg"},
 
 	// sprzeć → sprzał (NOT sparł - different from s+przeć)
 	"sprzeć": {stem: "sprza", virile: "sprze"},
@@ -300,11 +313,6 @@ var irregularPastSpecs = map[string]pastSpec{
 	// wetrzeć → wtarł (we- assimilates to w-)
 	"wetrzeć": {stem: "wtar"},
 
-	// otworzyć family: special stem twar-
-	"otworzyć":    {stem: "otwar"},
-	"przetworzyć": {stem: "przetwar"},
-	"roztworzyć":  {stem: "roztwar"},
-
 	// prać epenthetic forms: keep epenthetic vowel throughout
 	"obeprać":  {stem: "obepra"},
 	"odeprać":  {stem: "odepra"},
@@ -387,7 +395,9 @@ var irregularPastSpecs = map[string]pastSpec{
 	// mieć → miał
 	"mieć": {stem: "mia", virile: "mie"},
 
-	// chcieć → chciał
+	// chcieć → chciał. Deliberately not in prefixableVerbs: the prefix
+	// lookup drops epenthetic vowels (zetrzeć → starł), but chcieć keeps them
+	// (zechciał, odechciał), which the -eć heuristic already produces.
 	"chcieć": {stem: "chcia", virile: "chcie"},
 
 	// wiedzieć → wiedział
@@ -405,36 +415,15 @@ var irregularPastSpecs = map[string]pastSpec{
 	// musieć → musiał
 	"musieć": {stem: "musia", virile: "musie"},
 
-	// móc → mógł/mogła (ó→o alternation)
-	"móc": {stem: "mog", sg3m: "mógł"},
-
-	// biec → biegł (suppletive stem bieg-)
-	"biec": {stem: "bieg"},
-
 	// lec → legł
 	"lec": {stem: "leg"},
 
-	// rzec → rzekł
-	"rzec": {stem: "rzek"},
-
-	// ciec → ciekł
-	"ciec": {stem: "ciek"},
-
-	// strzec → strzegł
-	"strzec": {stem: "strzeg"},
-
-	// przesiąc → przesiąkł (archaic shortened form of przesiąknąć)
-	"przesiąc": {stem: "przesiąk"},
-
 	// schnąć → sechł/schła (epenthetic 'e' ONLY in sg3m)
 	"schnąć": {stem: "sch", sg3m: "sechł"},
 
 	// przysięgnąć → przysiągł/przysięgła (ę→ą alternation in masculine)
 	"przysięgnąć": {masc: "przysiąg", fem: "przysięg"},
 
-	// piec → piekł
-	"piec": {stem: "piek"},
-
 	// wlec: handled via pastHomographs
 
 	// siąść family
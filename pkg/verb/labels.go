@@ -0,0 +1,133 @@
+package verb
+
+import "fmt"
+
+// Labels selects the label set used when listing paradigm forms.
+type Labels int
+
+const (
+	PolishLabels  Labels = iota // ja, ty, on/ona...
+	EnglishLabels               // I, you, he/she...
+	AbbrevLabels                // 1sg, 2sg, 3sg...
+)
+
+// String returns the short name of the label set, as accepted by ParseLabels.
+func (l Labels) String() string {
+	switch l {
+	case PolishLabels:
+		return "pl"
+	case EnglishLabels:
+		return "en"
+	case AbbrevLabels:
+		return "abbr"
+	default:
+		return fmt.Sprintf("Labels(%d)", int(l))
+	}
+}
+
+// ParseLabels parses a label set name: "pl", "en" or "abbr".
+func ParseLabels(s string) (Labels, error) {
+	switch s {
+	case "pl":
+		return PolishLabels, nil
+	case "en":
+		return EnglishLabels, nil
+	case "abbr":
+		return AbbrevLabels, nil
+	default:
+		return 0, fmt.Errorf("unknown label set: %q (want pl, en or abbr)", s)
+	}
+}
+
+// Form is a single labeled form of a paradigm.
+type Form struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// presentLabels lists labels in PresentTense field order.
+var presentLabels = map[Labels][6]string{
+	PolishLabels:  {"ja", "ty", "on/ona", "my", "wy", "oni/one"},
+	EnglishLabels: {"I", "you", "he/she", "we", "you (pl)", "they"},
+	AbbrevLabels:  {"1sg", "2sg", "3sg", "1pl", "2pl", "3pl"},
+}
+
+// pastLabels lists labels in PastTense field order.
+// v = masculine-personal (virile), nv = non-masculine-personal.
+var pastLabels = map[Labels][13]string{
+	PolishLabels: {
+		"ja (m)", "ja (f)", "ty (m)", "ty (f)",
+		"on", "ona", "ono",
+		"my (v)", "my (nv)", "wy (v)", "wy (nv)", "oni", "one",
+	},
+	EnglishLabels: {
+		"I (m)", "I (f)", "you (m)", "you (f)",
+		"he", "she", "it",
+		"we (v)", "we (nv)", "you pl (v)", "you pl (nv)", "they (v)", "they (nv)",
+	},
+	AbbrevLabels: {
+		"1sg.m", "1sg.f", "2sg.m", "2sg.f",
+		"3sg.m", "3sg.f", "3sg.n",
+		"1pl.v", "1pl.nv", "2pl.v", "2pl.nv", "3pl.v", "3pl.nv",
+	},
+}
+
+// Forms returns the six present tense forms in order, labeled with the given label set.
+func (p PresentTense) Forms(labels Labels) []Form {
+	l := presentLabels[labels]
+	values := [6]string{p.Sg1, p.Sg2, p.Sg3, p.Pl1, p.Pl2, p.Pl3}
+	forms := make([]Form, len(values))
+	for i, v := range values {
+		forms[i] = Form{Label: l[i], Value: v}
+	}
+	return forms
+}
+
+// Forms returns the 13 past tense forms in order, labeled with the given label set.
+func (p PastTense) Forms(labels Labels) []Form {
+	l := pastLabels[labels]
+	values := [13]string{
+		p.Sg1M, p.Sg1F, p.Sg2M, p.Sg2F,
+		p.Sg3M, p.Sg3F, p.Sg3N,
+		p.Pl1V, p.Pl1NV, p.Pl2V, p.Pl2NV, p.Pl3V, p.Pl3NV,
+	}
+	forms := make([]Form, len(values))
+	for i, v := range values {
+		forms[i] = Form{Label: l[i], Value: v}
+	}
+	return forms
+}
+
+// Forms returns the future forms labeled with the given label set: the six
+// present-shaped forms (będę czytać, or napiszę for a synthetic future),
+// then, for an analytic future, the 13 l-participle forms (będę czytał).
+// Slots emptied by WithStyle are left out.
+func (p FutureParadigm) Forms(labels Labels) []Form {
+	var forms []Form
+	for _, f := range p.PresentTense.Forms(labels) {
+		if f.Value != "" {
+			forms = append(forms, f)
+		}
+	}
+	if p.Participle != nil {
+		forms = append(forms, p.Participle.Forms(labels)...)
+	}
+	return forms
+}
+
+// Forms returns the 13 conditional forms in order, labeled as the past
+// tense.
+func (p ConditionalParadigm) Forms(labels Labels) []Form {
+	return p.PastTense.Forms(labels)
+}
+
+// Forms returns the three imperative forms (2sg, 1pl, 2pl) in order,
+// labeled with the given label set.
+func (p ImperativeParadigm) Forms(labels Labels) []Form {
+	t := p.Table(labels)
+	forms := make([]Form, len(t.Rows))
+	for i, row := range t.Rows {
+		forms[i] = Form{Label: row.Label, Value: row.Forms[0]}
+	}
+	return forms
+}
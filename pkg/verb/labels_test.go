@@ -0,0 +1,97 @@
+package verb
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPresentTenseForms(t *testing.T) {
+	p := PresentTense{
+		Sg1: "czytam", Sg2: "czytasz", Sg3: "czyta",
+		Pl1: "czytamy", Pl2: "czytacie", Pl3: "czytają",
+	}
+	tests := []struct {
+		labels    Labels
+		wantFirst string
+		wantLast  string
+	}{
+		{PolishLabels, "ja", "oni/one"},
+		{EnglishLabels, "I", "they"},
+		{AbbrevLabels, "1sg", "3pl"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.labels.String(), func(t *testing.T) {
+			forms := p.Forms(tt.labels)
+			if len(forms) != 6 {
+				t.Fatalf("Forms(%v) returned %d forms, want 6", tt.labels, len(forms))
+			}
+			if forms[0] != (Form{tt.wantFirst, "czytam"}) {
+				t.Errorf("Forms(%v)[0] = %+v", tt.labels, forms[0])
+			}
+			if forms[5] != (Form{tt.wantLast, "czytają"}) {
+				t.Errorf("Forms(%v)[5] = %+v", tt.labels, forms[5])
+			}
+		})
+	}
+}
+
+func TestPastTenseForms(t *testing.T) {
+	paradigms, err := ConjugatePast("czytać")
+	if err != nil {
+		t.Fatalf("ConjugatePast(czytać) error: %v", err)
+	}
+	forms := paradigms[0].PastTense.Forms(AbbrevLabels)
+	if len(forms) != 13 {
+		t.Fatalf("Forms returned %d forms, want 13", len(forms))
+	}
+	if forms[6] != (Form{"3sg.n", "czytało"}) {
+		t.Errorf("Forms[6] = %+v, want 3sg.n czytało", forms[6])
+	}
+	if forms[12] != (Form{"3pl.nv", "czytały"}) {
+		t.Errorf("Forms[12] = %+v, want 3pl.nv czytały", forms[12])
+	}
+}
+
+func TestParseLabels(t *testing.T) {
+	for _, l := range []Labels{PolishLabels, EnglishLabels, AbbrevLabels} {
+		got, err := ParseLabels(l.String())
+		if err != nil || got != l {
+			t.Errorf("ParseLabels(%q) = %v, %v; want %v", l.String(), got, err, l)
+		}
+	}
+	if _, err := ParseLabels("de"); err == nil {
+		t.Error("ParseLabels(\"de\") returned no error")
+	}
+}
+
+func TestMoodAndFutureForms(t *testing.T) {
+	future, err := ConjugateFuture("czytać")
+	if err != nil {
+		t.Fatalf("ConjugateFuture(czytać) error: %v", err)
+	}
+	forms := future[0].Forms(AbbrevLabels)
+	if len(forms) != 19 || forms[0] != (Form{"1sg", "będę czytać"}) || forms[6] != (Form{"1sg.m", "będę czytał"}) {
+		t.Errorf("future Forms = %+v, want 6 infinitive forms then 13 participle forms", forms)
+	}
+	if forms := future[0].WithStyle(FutureInfinitive).Forms(AbbrevLabels); len(forms) != 6 {
+		t.Errorf("future Forms with FutureInfinitive = %+v, want 6", forms)
+	}
+
+	conditional, err := ConjugateConditional("czytać")
+	if err != nil {
+		t.Fatalf("ConjugateConditional(czytać) error: %v", err)
+	}
+	if forms := conditional[0].Forms(AbbrevLabels); len(forms) != 13 || forms[12] != (Form{"3pl.nv", "czytałyby"}) {
+		t.Errorf("conditional Forms = %+v, want 13 ending in czytałyby", forms)
+	}
+
+	imperative, err := Imperative("czytać")
+	if err != nil {
+		t.Fatalf("Imperative(czytać) error: %v", err)
+	}
+	want := []Form{{"you", "czytaj"}, {"we", "czytajmy"}, {"you (pl)", "czytajcie"}}
+	if got := imperative[0].Forms(EnglishLabels); !slices.Equal(got, want) {
+		t.Errorf("imperative Forms = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+package verb
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// combiningMarks are the combining diacritics of Polish letters: acute
+// (ć, ń, ó, ś, ź), dot above (ż) and ogonek (ą, ę). ł has no decomposed
+// form.
+const combiningMarks = "\u0301\u0307\u0328"
+
+// polishComposer maps each decomposed Polish letter to its precomposed
+// (NFC) form.
+var polishComposer = strings.NewReplacer(
+	"c\u0301", "ć", "n\u0301", "ń", "o\u0301", "ó", "s\u0301", "ś", "z\u0301", "ź",
+	"C\u0301", "Ć", "N\u0301", "Ń", "O\u0301", "Ó", "S\u0301", "Ś", "Z\u0301", "Ź",
+	"z\u0307", "ż", "Z\u0307", "Ż",
+	"a\u0328", "ą", "e\u0328", "ę", "A\u0328", "Ą", "E\u0328", "Ę",
+)
+
+// composePolish returns s with decomposed Polish letters (c followed by a
+// combining acute, as some keyboards and web forms send ć) composed, so
+// suffix checks compare like with like. It covers the Polish alphabet
+// only, not full NFC.
+func composePolish(s string) string {
+	if !strings.ContainsAny(s, combiningMarks) {
+		return s
+	}
+	return polishComposer.Replace(s)
+}
+
+// normalizeInfinitive cleans up an infinitive as typed or pasted: spaces
+// trimmed and collapsed, diacritics composed, letters lowercased
+// (" ŁOWIĆ " → łowić). It also reports whether the input began with a
+// capital letter, so entry points can capitalize their forms to match.
+func normalizeInfinitive(s string) (string, bool) {
+	s = composePolish(strings.Join(strings.Fields(s), " "))
+	first, _ := utf8.DecodeRuneInString(s)
+	return strings.ToLower(s), unicode.IsUpper(first)
+}
+
+// capitalize upper-cases the first letter of a form: robię → Robię.
+func capitalize(s string) string {
+	first, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+	return string(unicode.ToUpper(first)) + s[size:]
+}
+
+// capitalized capitalizes every form of a present tense paradigm.
+func (p PresentTense) capitalized() PresentTense {
+	return PresentTense{
+		Sg1: capitalize(p.Sg1), Sg2: capitalize(p.Sg2), Sg3: capitalize(p.Sg3),
+		Pl1: capitalize(p.Pl1), Pl2: capitalize(p.Pl2), Pl3: capitalize(p.Pl3),
+	}
+}
+
+// capitalized capitalizes every form of a past tense paradigm.
+func (p PastTense) capitalized() PastTense {
+	return PastTense{
+		Sg1M: capitalize(p.Sg1M), Sg1F: capitalize(p.Sg1F),
+		Sg2M: capitalize(p.Sg2M), Sg2F: capitalize(p.Sg2F),
+		Sg3M: capitalize(p.Sg3M), Sg3F: capitalize(p.Sg3F), Sg3N: capitalize(p.Sg3N),
+		Pl1V: capitalize(p.Pl1V), Pl1NV: capitalize(p.Pl1NV),
+		Pl2V: capitalize(p.Pl2V), Pl2NV: capitalize(p.Pl2NV),
+		Pl3V: capitalize(p.Pl3V), Pl3NV: capitalize(p.Pl3NV),
+	}
+}
@@ -0,0 +1,116 @@
+package verb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecomposedInput(t *testing.T) {
+	composed := "robić"
+	decomposed := "robic\u0301"
+
+	present, err := ConjugatePresent(decomposed)
+	if err != nil {
+		t.Fatalf("ConjugatePresent(decomposed robić): %v", err)
+	}
+	want, _ := ConjugatePresent(composed)
+	if !reflect.DeepEqual(present, want) {
+		t.Errorf("ConjugatePresent(decomposed) = %+v, want %+v", present, want)
+	}
+
+	past, err := ConjugatePast(decomposed)
+	if err != nil {
+		t.Fatalf("ConjugatePast(decomposed robić): %v", err)
+	}
+	wantPast, _ := ConjugatePast(composed)
+	if !reflect.DeepEqual(past, wantPast) {
+		t.Errorf("ConjugatePast(decomposed) = %+v, want %+v", past, wantPast)
+	}
+
+	vn, err := VerbalNoun(decomposed)
+	if err != nil {
+		t.Fatalf("VerbalNoun(decomposed robić): %v", err)
+	}
+	wantVN, _ := VerbalNoun(composed)
+	if !reflect.DeepEqual(vn, wantVN) {
+		t.Errorf("VerbalNoun(decomposed) = %v, want %v", vn, wantVN)
+	}
+}
+
+func TestComposePolish(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"z\u0307o\u0301łwia\u0328tko", "żółwiątko"},
+		{"ne\u0328kac\u0301 sie\u0328", "nękać się"},
+		{"S\u0301WIT", "ŚWIT"},
+		{"czytać", "czytać"},
+		{"x\u0301", "x\u0301"}, // not a Polish letter: left alone
+	}
+	for _, tt := range tests {
+		if got := composePolish(tt.in); got != tt.want {
+			t.Errorf("composePolish(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCaseAndSpaceTolerantInput(t *testing.T) {
+	tests := []struct {
+		input         string
+		sg1, sg3m, vn string
+	}{
+		{"Czytać ", "Czytam", "Czytał", "Czytanie"},
+		{"ROBIĆ", "Robię", "Robił", "Robienie"},
+		{"  łowić\t", "łowię", "łowił", "łowienie"},
+		{"ŁOWIĆ", "Łowię", "Łowił", "Łowienie"},
+		{"Bać  się", "Boję się", "Bał się", "Banie się"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			present, err := ConjugatePresent(tt.input)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q): %v", tt.input, err)
+			}
+			past, err := ConjugatePast(tt.input)
+			if err != nil {
+				t.Fatalf("ConjugatePast(%q): %v", tt.input, err)
+			}
+			vn, err := VerbalNoun(tt.input)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q): %v", tt.input, err)
+			}
+			got := []string{present[0].Sg1, past[0].Sg3M, vn[0]}
+			want := []string{tt.sg1, tt.sg3m, tt.vn}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%q conjugates to %v, want %v", tt.input, got, want)
+			}
+		})
+	}
+
+	// Other entry points accept the same input and answer in lowercase
+	if got, err := Imperative("CZYTAĆ"); err != nil || got[0].Sg2 != "czytaj" {
+		t.Errorf("Imperative(CZYTAĆ) = %+v, %v; want czytaj", got, err)
+	}
+	if Frequency(" Być") != Frequency("być") {
+		t.Error("Frequency( Być) differs from Frequency(być)")
+	}
+}
+
+func TestNormalizeInfinitive(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		capital bool
+	}{
+		{"czytać", "czytać", false},
+		{"ÓSEMKOWAĆ", "ósemkować", true}, // Ó lowercases to ó
+		{"ŹREĆ", "źreć", true},
+		{" Śmiać   się ", "śmiać się", true},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, capital := normalizeInfinitive(tt.in)
+		if got != tt.want || capital != tt.capital {
+			t.Errorf("normalizeInfinitive(%q) = %q, %v; want %q, %v", tt.in, got, capital, tt.want, tt.capital)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package verb
+
+import (
+	"encoding/json"
+	"html"
+	"io"
+)
+
+// HTMLEscapeForms returns a copy of forms with labels and values escaped
+// for HTML text and attribute contexts. Polish diacritics are plain UTF-8
+// and pass through unchanged; only markup characters (<, >, &, ', ") are
+// escaped, e.g. the "on/ona" labels stay as they are.
+func HTMLEscapeForms(forms []Form) []Form {
+	escaped := make([]Form, len(forms))
+	for i, f := range forms {
+		escaped[i] = Form{
+			Label: html.EscapeString(f.Label),
+			Value: html.EscapeString(f.Value),
+		}
+	}
+	return escaped
+}
+
+// WriteJSONL writes forms as newline-delimited JSON, one
+// {"label":...,"value":...} object per line. Text is written as raw UTF-8
+// and, unlike json.Marshal, <, > and & are not turned into \u escapes.
+func WriteJSONL(w io.Writer, forms []Form) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for _, f := range forms {
+		if err := enc.Encode(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// paradigmJSON is the JSON shape of every paradigm type: the gloss and
+// confidence next to an object of forms keyed as in PresentTense (sg1,
+// sg2...) or PastTense (sg1m, sg1f...):
+//
+//	{"gloss": "to stand", "confidence": "high", "forms": {"sg1": "stoję", ...}}
+type paradigmJSON struct {
+	Gloss      string          `json:"gloss,omitempty"`
+	Confidence Confidence      `json:"confidence"`
+	Source     string          `json:"source,omitempty"` // ExplainedParadigm only
+	Defective  []Slot          `json:"defective,omitempty"`
+	Forms      json.RawMessage `json:"forms"`
+}
+
+// marshalParadigm encodes a paradigm in the paradigmJSON shape, with j
+// holding everything but the forms.
+func marshalParadigm(j paradigmJSON, forms any) ([]byte, error) {
+	raw, err := json.Marshal(forms)
+	if err != nil {
+		return nil, err
+	}
+	j.Forms = raw
+	return json.Marshal(j)
+}
+
+// unmarshalParadigm decodes a paradigm in the paradigmJSON shape into
+// forms and returns the rest. The flat shape of the corpus files, with
+// the forms next to the other fields, is accepted too.
+func unmarshalParadigm(data []byte, forms any) (paradigmJSON, error) {
+	var j paradigmJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return j, err
+	}
+	raw := j.Forms
+	if len(raw) == 0 {
+		raw = data
+	}
+	return j, json.Unmarshal(raw, forms)
+}
+
+// MarshalJSON encodes the paradigm as {gloss, confidence, forms}.
+func (p Paradigm) MarshalJSON() ([]byte, error) {
+	return marshalParadigm(paradigmJSON{Gloss: p.Gloss, Confidence: p.Confidence, Defective: p.Defective}, p.PresentTense)
+}
+
+// UnmarshalJSON decodes a paradigm written by MarshalJSON or a flat
+// corpus entry.
+func (p *Paradigm) UnmarshalJSON(data []byte) error {
+	j, err := unmarshalParadigm(data, &p.PresentTense)
+	p.Gloss, p.Confidence, p.Defective = j.Gloss, j.Confidence, j.Defective
+	return err
+}
+
+// MarshalJSON encodes the paradigm as {gloss, confidence, forms}.
+func (p PastParadigm) MarshalJSON() ([]byte, error) {
+	return marshalParadigm(paradigmJSON{Gloss: p.Gloss, Confidence: p.Confidence, Defective: p.Defective}, p.PastTense)
+}
+
+// UnmarshalJSON decodes a paradigm written by MarshalJSON or a flat
+// corpus entry.
+func (p *PastParadigm) UnmarshalJSON(data []byte) error {
+	j, err := unmarshalParadigm(data, &p.PastTense)
+	p.Gloss, p.Confidence, p.Defective = j.Gloss, j.Confidence, j.Defective
+	return err
+}
+
+// MarshalJSON encodes the paradigm like Paradigm, with its source.
+func (p ExplainedParadigm) MarshalJSON() ([]byte, error) {
+	return marshalParadigm(paradigmJSON{Gloss: p.Gloss, Confidence: p.Confidence, Source: p.Source, Defective: p.Defective}, p.PresentTense)
+}
+
+// UnmarshalJSON decodes a paradigm written by MarshalJSON.
+func (p *ExplainedParadigm) UnmarshalJSON(data []byte) error {
+	j, err := unmarshalParadigm(data, &p.PresentTense)
+	p.Gloss, p.Confidence, p.Source, p.Defective = j.Gloss, j.Confidence, j.Source, j.Defective
+	return err
+}
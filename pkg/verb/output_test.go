@@ -0,0 +1,138 @@
+package verb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"html"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func pastFormsOf(t *testing.T, infinitive string) []Form {
+	t.Helper()
+	paradigms, err := ConjugatePast(infinitive)
+	if err != nil {
+		t.Fatalf("ConjugatePast(%q) error: %v", infinitive, err)
+	}
+	return paradigms[0].Forms(PolishLabels)
+}
+
+func TestHTMLEscapeForms(t *testing.T) {
+	forms := pastFormsOf(t, "zażółcić")
+	escaped := HTMLEscapeForms(forms)
+
+	// Diacritics need no escaping, so plain forms come back unchanged.
+	if !slices.Equal(escaped, forms) {
+		t.Errorf("HTMLEscapeForms changed forms without markup: %v", escaped)
+	}
+
+	got := HTMLEscapeForms([]Form{{Label: "<b>ja</b>", Value: "żółć & \"gęśl\""}})
+	want := Form{Label: "&lt;b&gt;ja&lt;/b&gt;", Value: "żółć &amp; &#34;gęśl&#34;"}
+	if got[0] != want {
+		t.Errorf("HTMLEscapeForms = %+v, want %+v", got[0], want)
+	}
+	if html.UnescapeString(got[0].Value) != "żółć & \"gęśl\"" {
+		t.Errorf("round trip lost characters: %q", html.UnescapeString(got[0].Value))
+	}
+}
+
+func TestWriteJSONL(t *testing.T) {
+	forms := pastFormsOf(t, "zażółcić")
+
+	var buf bytes.Buffer
+	if err := WriteJSONL(&buf, forms); err != nil {
+		t.Fatalf("WriteJSONL error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "zażółciłam") {
+		t.Errorf("WriteJSONL escaped diacritics:\n%s", buf.String())
+	}
+
+	var decoded []Form
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var f Form
+		if err := json.Unmarshal(scanner.Bytes(), &f); err != nil {
+			t.Fatalf("line %q: %v", scanner.Text(), err)
+		}
+		decoded = append(decoded, f)
+	}
+	if !slices.Equal(decoded, forms) {
+		t.Errorf("JSONL round trip = %v, want %v", decoded, forms)
+	}
+}
+
+func TestParadigmJSON(t *testing.T) {
+	paradigms, err := ConjugatePresent("stać")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.Marshal(paradigms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[` +
+		`{"gloss":"to stand","confidence":"high","forms":` +
+		`{"sg1":"stoję","sg2":"stoisz","sg3":"stoi","pl1":"stoimy","pl2":"stoicie","pl3":"stoją"}},` +
+		`{"gloss":"to become, to afford","confidence":"high","forms":` +
+		`{"sg1":"stanę","sg2":"staniesz","sg3":"stanie","pl1":"staniemy","pl2":"staniecie","pl3":"staną"}}` +
+		`]`
+	if string(got) != want {
+		t.Errorf("json.Marshal(stać) =\n%s\nwant\n%s", got, want)
+	}
+
+	var back []Paradigm
+	if err := json.Unmarshal(got, &back); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back, paradigms) {
+		t.Errorf("round trip = %+v, want %+v", back, paradigms)
+	}
+}
+
+func TestPastParadigmJSONRoundTrip(t *testing.T) {
+	paradigms, err := ConjugatePast("paść")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(paradigms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"forms":{"sg1m":"pasłem",`) {
+		t.Errorf("json.Marshal(paść) = %s, want forms under \"forms\"", data)
+	}
+	var back []PastParadigm
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back, paradigms) {
+		t.Errorf("round trip = %+v, want %+v", back, paradigms)
+	}
+}
+
+func TestParadigmUnmarshalFlat(t *testing.T) {
+	// corpus files list the forms next to the infinitive
+	data := `{"infinitive":"czytać","sg1":"czytam","sg2":"czytasz","sg3":"czyta",` +
+		`"pl1":"czytamy","pl2":"czytacie","pl3":"czytają","aspect":"imperf"}`
+	var p Paradigm
+	if err := json.Unmarshal([]byte(data), &p); err != nil {
+		t.Fatal(err)
+	}
+	want := PresentTense{Sg1: "czytam", Sg2: "czytasz", Sg3: "czyta", Pl1: "czytamy", Pl2: "czytacie", Pl3: "czytają"}
+	if p.PresentTense != want {
+		t.Errorf("Unmarshal(flat) = %+v, want %+v", p.PresentTense, want)
+	}
+}
+
+func TestConfidenceUnmarshalText(t *testing.T) {
+	var c Confidence
+	if err := json.Unmarshal([]byte(`"low"`), &c); err != nil || c != Low {
+		t.Errorf(`Unmarshal("low") = %v, %v; want low`, c, err)
+	}
+	if err := json.Unmarshal([]byte(`"sure"`), &c); err == nil {
+		t.Error(`Unmarshal("sure"): expected error`)
+	}
+}
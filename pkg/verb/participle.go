@@ -0,0 +1,296 @@
+package verb
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrNoPassiveParticiple is returned by PassiveParticiple for verbs known
+// to have no passive: the modals móc and musieć, być, and iść with its
+// prefixed -jść forms.
+var ErrNoPassiveParticiple = errors.New("verb has no passive adjectival participle")
+
+// noPassiveVerbs are the verbs whose verbal noun would give a participle
+// that is not a word: możony, musiany, byty. The iść family is matched
+// by isIscFamily.
+var noPassiveVerbs = map[string]bool{
+	"móc": true, "musieć": true, "być": true,
+}
+
+// isIscFamily reports whether a verb is iść or a prefixed -jść verb
+// (pójść, przyjść, wejść), which have neither a passive participle nor
+// an -no/-to impersonal past.
+func isIscFamily(infinitive string) bool {
+	return infinitive == "iść" || strings.HasSuffix(infinitive, "jść")
+}
+
+// PassiveParticiple returns the passive adjectival participle (imiesłów
+// przymiotnikowy bierny) of a verb in the masculine nominative singular:
+// czytany, robiony, noszony, wzięty, otwarty. Homographs and verbal noun
+// variants give one form each.
+//
+// Transitivity is not checked in general, so most intransitive verbs
+// still get the morphological form (spany). The modals, być and the iść
+// family return ErrNoPassiveParticiple. Reflexive verbs have no passive;
+// się is dropped and the base verb's participle returned.
+func PassiveParticiple(infinitive string) ([]string, error) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	if base, _ := splitReflexive(infinitive); noPassiveVerbs[base] || isIscFamily(base) {
+		return nil, fmt.Errorf("%q: %w", infinitive, ErrNoPassiveParticiple)
+	}
+	stems, err := passiveParticipleStems(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	forms := make([]string, len(stems))
+	for i, s := range stems {
+		forms[i] = s + "y"
+	}
+	return forms, nil
+}
+
+// ErrNoImpersonalPast is returned by ImpersonalPast for verbs with neither
+// an -no/-to form nor a się construction: móc, whose impersonal is the
+// analytic można było.
+var ErrNoImpersonalPast = errors.New("verb has no impersonal past")
+
+// noImpersonalPastVerbs are the verbs ImpersonalPast rejects.
+var noImpersonalPastVerbs = map[string]bool{
+	"móc": true,
+}
+
+// impersonalPastOverrides are impersonal pasts not built on a participle
+// stem: być has the neuter było.
+var impersonalPastOverrides = map[string]string{
+	"być": "było",
+}
+
+// ImpersonalPast returns the impersonal past (forma bezosobowa) of a verb:
+// the passive participle stem plus -o, so -ny verbs take -no (czytano,
+// noszono, widziano) and -ty verbs take -to (wzięto, zaczęto). Unlike the
+// participle, reflexive verbs keep się: śmiano się. Verbs with several
+// stems return the first.
+//
+// The iść family has no -no form and uses the neuter past with się
+// (szło się, poszło się). móc returns ErrNoImpersonalPast; musieć keeps
+// the regular musiano.
+func ImpersonalPast(infinitive string) (string, error) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	if base, ok := splitReflexive(infinitive); ok {
+		form, err := ImpersonalPast(base)
+		if err != nil || isIscFamily(base) {
+			return form, err // already szło się
+		}
+		return form + reflexiveParticle, nil
+	}
+
+	if noImpersonalPastVerbs[infinitive] {
+		return "", fmt.Errorf("%q: %w", infinitive, ErrNoImpersonalPast)
+	}
+	if form, ok := impersonalPastOverrides[infinitive]; ok {
+		return form, nil
+	}
+	if isIscFamily(infinitive) {
+		past, err := ConjugatePast(infinitive)
+		if err != nil {
+			return "", err
+		}
+		return past[0].Sg3N + reflexiveParticle, nil
+	}
+
+	stems, err := passiveParticipleStems(infinitive)
+	if err != nil {
+		return "", err
+	}
+	return stems[0] + "o", nil
+}
+
+// passiveParticipleStems returns the participle stems that the adjectival
+// endings attach to: czytan-y, noszon-a, wzięt-e.
+//
+// The stem is read off the verbal noun, which already carries the stem
+// alternations and suppletive stems:
+//   - -anie → -an: czytanie → czytan
+//   - -enie → -on: noszenie → noszon, mielenie → mielon
+//   - -cie → -t: wzięcie → wzięt, otwarcie → otwart, picie → pit
+//
+// -eć verbs with an -ał past keep the past stem instead: widział →
+// widzian, chciał → chcian.
+func passiveParticipleStems(infinitive string) ([]string, error) {
+	base, _ := splitReflexive(infinitive)
+
+	if strings.HasSuffix(base, "eć") {
+		if stems, ok := passiveStemsFromPast(base); ok {
+			return stems, nil
+		}
+	}
+
+	nouns, err := VerbalNoun(base)
+	if err != nil {
+		return nil, err
+	}
+	stems := make([]string, 0, len(nouns))
+	for _, noun := range nouns {
+		var stem string
+		switch {
+		case strings.HasSuffix(noun, "anie"):
+			stem = strings.TrimSuffix(noun, "ie")
+		case strings.HasSuffix(noun, "enie"):
+			stem = strings.TrimSuffix(noun, "enie") + "on"
+		case strings.HasSuffix(noun, "cie"):
+			stem = strings.TrimSuffix(noun, "cie") + "t"
+		default:
+			return nil, &ConjugationError{Infinitive: infinitive, Form: "passive participle"}
+		}
+		if !slices.Contains(stems, stem) {
+			stems = append(stems, stem)
+		}
+	}
+	return stems, nil
+}
+
+// passiveStemsFromPast builds the participle stem of an -eć verb from an
+// -ał past: widział → widzian, słyszał → słyszan. Verbs with another past
+// (mełł, tarł) fall through to the verbal noun.
+func passiveStemsFromPast(infinitive string) ([]string, bool) {
+	past, err := ConjugatePast(infinitive)
+	if err != nil {
+		return nil, false
+	}
+	var stems []string
+	for _, p := range past {
+		stem, ok := strings.CutSuffix(p.Sg3M, "ał")
+		if !ok {
+			return nil, false
+		}
+		stems = append(stems, stem+"an")
+	}
+	return stems, true
+}
+
+// ErrNoContemporaryAdverbial is returned by ContemporaryAdverbial for
+// perfective verbs, which have no -ąc participle.
+var ErrNoContemporaryAdverbial = errors.New("perfective verbs have no contemporary adverbial participle")
+
+// ContemporaryAdverbial returns the contemporary adverbial participle
+// (imiesłów przysłówkowy współczesny) of a verb: the present 3pl with -ą
+// replaced by -ąc, which carries the stem alternations along: czytają →
+// czytając, niosą → niosąc, idą → idąc. Reflexive verbs keep się as a
+// separate word: śmiejąc się.
+//
+// The participle exists only for imperfective verbs; verbs known to be
+// perfective return ErrNoContemporaryAdverbial. Verbs of unknown aspect
+// get the form. Present tense homographs use the first paradigm (stać →
+// stojąc).
+func ContemporaryAdverbial(infinitive string) (string, error) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	if base, ok := splitReflexive(infinitive); ok {
+		form, err := ContemporaryAdverbial(base)
+		if err != nil {
+			return "", err
+		}
+		return form + reflexiveParticle, nil
+	}
+
+	if aspect, _ := Aspect(infinitive); aspect == Perfective {
+		return "", fmt.Errorf("%q: %w", infinitive, ErrNoContemporaryAdverbial)
+	}
+	stem, err := presentParticipleStem(infinitive)
+	if err != nil {
+		return "", err
+	}
+	return stem + "ąc", nil
+}
+
+// ErrNoActiveParticiple is returned by ActiveParticiple for perfective
+// verbs, which have no -ący participle.
+var ErrNoActiveParticiple = errors.New("perfective verbs have no active adjectival participle")
+
+// ActiveParticiple returns the active adjectival participle (imiesłów
+// przymiotnikowy czynny) of a verb in the masculine nominative singular:
+// the present 3pl with -ą replaced by -ący (czytający, piszący, niosący,
+// starzejący). Reflexive verbs keep się as a separate word.
+//
+// Like ContemporaryAdverbial, it exists only for imperfective verbs;
+// verbs known to be perfective return ErrNoActiveParticiple.
+func ActiveParticiple(infinitive string) (string, error) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	if base, ok := splitReflexive(infinitive); ok {
+		form, err := ActiveParticiple(base)
+		if err != nil {
+			return "", err
+		}
+		return form + reflexiveParticle, nil
+	}
+
+	if aspect, _ := Aspect(infinitive); aspect == Perfective {
+		return "", fmt.Errorf("%q: %w", infinitive, ErrNoActiveParticiple)
+	}
+	stem, err := presentParticipleStem(infinitive)
+	if err != nil {
+		return "", err
+	}
+	return stem + "ący", nil
+}
+
+// presentParticipleStem returns the present 3pl without its -ą, the stem
+// of the -ąc and -ący participles: czytaj, nios, id. Present tense
+// homographs use the first paradigm; być uses its future stem (będ).
+func presentParticipleStem(infinitive string) (string, error) {
+	if infinitive == "być" {
+		return strings.TrimSuffix(buildBedPresent("").Pl3, "ą"), nil
+	}
+	present, err := ConjugatePresent(infinitive)
+	if err != nil {
+		return "", err
+	}
+	stem, ok := strings.CutSuffix(present[0].Pl3, "ą")
+	if !ok {
+		return "", &ConjugationError{Infinitive: infinitive, Form: "present participle"}
+	}
+	return stem, nil
+}
+
+// ErrNoAnteriorAdverbial is returned by AnteriorAdverbial for imperfective
+// verbs, which have no -wszy/-łszy participle.
+var ErrNoAnteriorAdverbial = errors.New("imperfective verbs have no anterior adverbial participle")
+
+// AnteriorAdverbial returns the anterior adverbial participle (imiesłów
+// przysłówkowy uprzedni) of a verb, built on the masculine 3sg past with
+// the -ł dropped: -wszy after a vowel (przeczytał → przeczytawszy, wziął
+// → wziąwszy) and -łszy after a consonant (przyszedł → przyszedłszy, zjadł
+// → zjadłszy, pomógł → pomógłszy). Suppletive past stems come from
+// ConjugatePast. Reflexive verbs keep się as a separate word.
+//
+// The participle exists only for perfective verbs; verbs known to be
+// imperfective return ErrNoAnteriorAdverbial. Past homographs use the
+// first paradigm.
+func AnteriorAdverbial(infinitive string) (string, error) {
+	infinitive, _ = normalizeInfinitive(infinitive)
+	if base, ok := splitReflexive(infinitive); ok {
+		form, err := AnteriorAdverbial(base)
+		if err != nil {
+			return "", err
+		}
+		return form + reflexiveParticle, nil
+	}
+
+	if aspect, _ := Aspect(infinitive); aspect == Imperfective {
+		return "", fmt.Errorf("%q: %w", infinitive, ErrNoAnteriorAdverbial)
+	}
+	past, err := ConjugatePast(infinitive)
+	if err != nil {
+		return "", err
+	}
+	stem, ok := strings.CutSuffix(past[0].Sg3M, "ł")
+	if !ok {
+		return "", &ConjugationError{Infinitive: infinitive, Form: "anterior adverbial"}
+	}
+	if last, _ := utf8.DecodeLastRuneInString(stem); isPolishVowel(last) {
+		return stem + "wszy", nil
+	}
+	return stem + "łszy", nil
+}
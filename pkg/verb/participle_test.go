@@ -0,0 +1,234 @@
+package verb
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPassiveParticiple(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       []string
+	}{
+		// -anie → -any
+		{"czytać", []string{"czytany"}},
+		{"napisać", []string{"napisany"}},
+		{"kupować", []string{"kupowany"}},
+		{"dać", []string{"dany"}},
+
+		// -enie → -ony, with the verbal noun's softening
+		{"robić", []string{"robiony"}},
+		{"nosić", []string{"noszony"}},
+		{"płacić", []string{"płacony"}},
+		{"zobaczyć", []string{"zobaczony"}},
+		{"nieść", []string{"niesiony"}},
+		{"znaleźć", []string{"znaleziony"}},
+		{"piec", []string{"pieczony"}},
+		{"zjeść", []string{"zjedzony"}},
+		{"mleć", []string{"mielony"}},
+
+		// -cie → -ty
+		{"wziąć", []string{"wzięty"}},
+		{"zacząć", []string{"zaczęty"}},
+		{"ciągnąć", []string{"ciągnięty"}},
+		{"kłuć", []string{"kłuty"}},
+		{"pić", []string{"pity"}},
+		{"myć", []string{"myty"}},
+		{"otworzyć", []string{"otwarty"}},
+		{"trzeć", []string{"tarty"}},
+
+		// -eć with an -ał past
+		{"widzieć", []string{"widziany"}},
+		{"słyszeć", []string{"słyszany"}},
+		{"powiedzieć", []string{"powiedziany"}},
+		{"zrozumieć", []string{"zrozumiany"}},
+
+		// No transitivity check; reflexives drop się
+		{"spać", []string{"spany"}},
+		{"umyć się", []string{"umyty"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := PassiveParticiple(tt.infinitive)
+			if err != nil {
+				t.Fatalf("PassiveParticiple(%q) error: %v", tt.infinitive, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("PassiveParticiple(%q) = %v, want %v", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPassiveParticipleNone(t *testing.T) {
+	for _, infinitive := range []string{"iść", "pójść", "przyjść", "wejść", "móc", "musieć", "być"} {
+		if got, err := PassiveParticiple(infinitive); !errors.Is(err, ErrNoPassiveParticiple) {
+			t.Errorf("PassiveParticiple(%q) = %v, %v; want ErrNoPassiveParticiple", infinitive, got, err)
+		}
+	}
+}
+
+func TestImpersonalPastNone(t *testing.T) {
+	for _, infinitive := range []string{"móc", "Móc"} {
+		if got, err := ImpersonalPast(infinitive); !errors.Is(err, ErrNoImpersonalPast) {
+			t.Errorf("ImpersonalPast(%q) = %q, %v; want ErrNoImpersonalPast", infinitive, got, err)
+		}
+	}
+}
+
+func TestContemporaryAdverbial(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       string
+	}{
+		{"czytać", "czytając"},
+		{"robić", "robiąc"},
+		{"nieść", "niosąc"},
+		{"iść", "idąc"},
+		{"pisać", "pisząc"},
+		{"dawać", "dając"},
+		{"kupować", "kupując"},
+		{"spać", "śpiąc"},
+		{"móc", "mogąc"},
+		{"jeść", "jedząc"},
+		{"wiedzieć", "wiedząc"},
+		{"być", "będąc"},
+		{"stać", "stojąc"},           // the imperfective reading
+		{"aresztować", "aresztując"}, // biaspectual
+		{"śmiać się", "śmiejąc się"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ContemporaryAdverbial(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ContemporaryAdverbial(%q) error: %v", tt.infinitive, err)
+			}
+			if got != tt.want {
+				t.Errorf("ContemporaryAdverbial(%q) = %q, want %q", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContemporaryAdverbialPerfective(t *testing.T) {
+	for _, inf := range []string{"zrobić", "przeczytać", "ocknąć się"} {
+		if _, err := ContemporaryAdverbial(inf); !errors.Is(err, ErrNoContemporaryAdverbial) {
+			t.Errorf("ContemporaryAdverbial(%q) error = %v, want ErrNoContemporaryAdverbial", inf, err)
+		}
+	}
+}
+
+func TestAnteriorAdverbial(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       string
+	}{
+		{"przeczytać", "przeczytawszy"},
+		{"zrobić", "zrobiwszy"},
+		{"wziąć", "wziąwszy"},
+		{"zacząć", "zacząwszy"},
+		{"umyć", "umywszy"},
+		{"przyjść", "przyszedłszy"},
+		{"zjeść", "zjadłszy"},
+		{"pomóc", "pomógłszy"},
+		{"przynieść", "przyniósłszy"},
+		{"zetrzeć", "starłszy"},
+		{"aresztować", "aresztowawszy"}, // biaspectual
+		{"ubrać się", "ubrawszy się"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := AnteriorAdverbial(tt.infinitive)
+			if err != nil {
+				t.Fatalf("AnteriorAdverbial(%q) error: %v", tt.infinitive, err)
+			}
+			if got != tt.want {
+				t.Errorf("AnteriorAdverbial(%q) = %q, want %q", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnteriorAdverbialImperfective(t *testing.T) {
+	for _, inf := range []string{"czytać", "robić", "móc", "śmiać się"} {
+		if _, err := AnteriorAdverbial(inf); !errors.Is(err, ErrNoAnteriorAdverbial) {
+			t.Errorf("AnteriorAdverbial(%q) error = %v, want ErrNoAnteriorAdverbial", inf, err)
+		}
+	}
+}
+
+func TestActiveParticiple(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       string
+	}{
+		{"czytać", "czytający"},
+		{"robić", "robiący"},
+		{"nieść", "niosący"},
+		{"pisać", "piszący"},
+		{"starzeć", "starzejący"},
+		{"umieć", "umiejący"},
+		{"kupować", "kupujący"},
+		{"być", "będący"},
+		{"jeść", "jedzący"},
+		{"śmiać się", "śmiejący się"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ActiveParticiple(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ActiveParticiple(%q) error: %v", tt.infinitive, err)
+			}
+			if got != tt.want {
+				t.Errorf("ActiveParticiple(%q) = %q, want %q", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ActiveParticiple("napisać"); !errors.Is(err, ErrNoActiveParticiple) {
+		t.Errorf("ActiveParticiple(napisać) error = %v, want ErrNoActiveParticiple", err)
+	}
+}
+
+func TestImpersonalPast(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       string
+	}{
+		{"czytać", "czytano"},
+		{"pisać", "pisano"},
+		{"robić", "robiono"},
+		{"nosić", "noszono"},
+		{"widzieć", "widziano"},
+		{"mieć", "miano"},
+		{"wziąć", "wzięto"},
+		{"zacząć", "zaczęto"},
+		{"pić", "pito"},
+		{"zjeść", "zjedzono"},
+		{"śmiać się", "śmiano się"},
+
+		// no passive: the iść family's neuter past with się
+		{"iść", "szło się"},
+		{"pójść", "poszło się"},
+		{"przyjść", "przyszło się"},
+		{"przejść się", "przeszło się"},
+		{"musieć", "musiano"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ImpersonalPast(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ImpersonalPast(%q) error: %v", tt.infinitive, err)
+			}
+			if got != tt.want {
+				t.Errorf("ImpersonalPast(%q) = %q, want %q", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
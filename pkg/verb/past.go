@@ -1,13 +1,51 @@
 package verb
 
 import (
-	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
 // ConjugatePast returns all valid past tense paradigms for a verb.
-// Most verbs return a single paradigm; homographs and dual-form verbs return multiple.
+// Most verbs return a single paradigm; homographs and dual-form verbs return multiple,
+// most frequent first where the embedded frequency data ranks them.
 func ConjugatePast(infinitive string) ([]PastParadigm, error) {
+	infinitive, capital := normalizeInfinitive(infinitive)
+	found, err := conjugatePast(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	paradigms := make([]PastParadigm, 0, len(found))
+	for _, p := range found {
+		paradigms = appendPastParadigm(paradigms, p)
+	}
+	sortParadigmsByFrequency(paradigms, func(p PastParadigm) int {
+		return paradigmRank(Past, infinitive, p.Sg3M)
+	})
+	if slots := defectiveSlots(infinitive, Past); slots != nil {
+		for i := range paradigms {
+			paradigms[i].Defective = slots
+		}
+	}
+	if capital {
+		for i := range paradigms {
+			paradigms[i].PastTense = paradigms[i].capitalized()
+		}
+	}
+	return paradigms, nil
+}
+
+// conjugatePast finds the past tense paradigms of a verb, which
+// ConjugatePast then deduplicates.
+func conjugatePast(infinitive string) ([]PastParadigm, error) {
+	if err := checkInfinitive(infinitive); err != nil {
+		return nil, err
+	}
+
+	// Reflexive verbs conjugate like their base: bać się → bałem się
+	if base, ok := splitReflexive(infinitive); ok {
+		return conjugatePastReflexive(base)
+	}
+
 	// Check homographs first (verbs with multiple valid paradigms)
 	if paradigms, ok := lookupPastHomograph(infinitive); ok {
 		return paradigms, nil
@@ -23,7 +61,7 @@ func ConjugatePast(infinitive string) ([]PastParadigm, error) {
 	if strings.HasSuffix(infinitive, "nijść") {
 		prefix := strings.TrimSuffix(infinitive, "nijść")
 		if prefix != "" {
-			return []PastParadigm{{PastTense: buildJscPast(prefix)}}, nil
+			return []PastParadigm{{PastTense: buildJscPast(prefix), Confidence: Medium}}, nil
 		}
 	}
 
@@ -31,7 +69,7 @@ func ConjugatePast(infinitive string) ([]PastParadigm, error) {
 	if strings.HasSuffix(infinitive, "jść") {
 		prefix := strings.TrimSuffix(infinitive, "jść")
 		if prefix != "" {
-			return []PastParadigm{{PastTense: buildJscPast(prefix)}}, nil
+			return []PastParadigm{{PastTense: buildJscPast(prefix), Confidence: Medium}}, nil
 		}
 	}
 
@@ -39,7 +77,7 @@ func ConjugatePast(infinitive string) ([]PastParadigm, error) {
 	if strings.HasSuffix(infinitive, "niść") {
 		prefix := strings.TrimSuffix(infinitive, "niść")
 		if prefix != "" {
-			return []PastParadigm{{PastTense: buildJscPast(prefix)}}, nil
+			return []PastParadigm{{PastTense: buildJscPast(prefix), Confidence: Medium}}, nil
 		}
 	}
 
@@ -47,7 +85,7 @@ func ConjugatePast(infinitive string) ([]PastParadigm, error) {
 	// Must come before prefix stripping because buildSchnacPast handles
 	// asymmetric epenthesis (strip in sg3m, keep in other forms).
 	if strings.HasSuffix(infinitive, "schnąć") && infinitive != "schnąć" {
-		return []PastParadigm{{PastTense: buildSchnacPast(infinitive)}}, nil
+		return []PastParadigm{{PastTense: buildSchnacPast(infinitive), Confidence: Medium}}, nil
 	}
 
 	// Check irregular verbs via prefix stripping
@@ -59,6 +97,15 @@ func ConjugatePast(infinitive string) ([]PastParadigm, error) {
 		return []PastParadigm{{PastTense: pt}}, nil
 	}
 
+	// Archaic -ąc/-ęc infinitives: zaprząc → zaprzągł, zaprzęgła. Other
+	// -ąc/-ęc infinitives have no past.
+	if isArchaicAcShape(infinitive) {
+		if pt, ok := archaicAcPast(infinitive); ok {
+			return []PastParadigm{{PastTense: pt, Confidence: Medium}}, nil
+		}
+		return nil, &ConjugationError{Infinitive: infinitive, Form: "past"}
+	}
+
 	// Check for dual-form -nąć verbs (both n-dropping and n-keeping valid)
 	if isDualFormNacVerb(infinitive) {
 		return buildDualFormNacParadigms(infinitive), nil
@@ -66,11 +113,11 @@ func ConjugatePast(infinitive string) ([]PastParadigm, error) {
 
 	// Try heuristics in order of specificity
 	for _, h := range pastHeuristics {
-		if p, ok := h(infinitive); ok {
-			return []PastParadigm{{PastTense: p}}, nil
+		if p, ok := h.fn(infinitive); ok {
+			return []PastParadigm{{PastTense: p, Confidence: heuristicConfidence(h.name)}}, nil
 		}
 	}
-	return nil, fmt.Errorf("no past tense heuristic matched: %s", infinitive)
+	return nil, &ConjugationError{Infinitive: infinitive, Form: "past"}
 }
 
 // buildDualFormNacParadigms returns both paradigms for verbs that can use
@@ -91,7 +138,7 @@ func buildDualFormNacParadigms(infinitive string) []PastParadigm {
 	stemWithoutNac := strings.TrimSuffix(infinitive, "nąć") // "kwit" or "klęk"
 	baseStem := strings.TrimSuffix(infinitive, "ąć")        // "kwitn" or "klękn"
 	mascNKeptStem := baseStem + "ą"                         // "kwitną" or "klękną"
-	femStem := baseStem + "ę"                               // "kwitnę" or "klęknę"
+	femStem := ApplyAToE(mascNKeptStem)                     // "kwitnę" or "klęknę"
 
 	// Determine virile plural type
 	var virileStem string
@@ -168,38 +215,44 @@ func buildDualFormNacParadigms(infinitive string) []PastParadigm {
 // pastHeuristic is a function that attempts to conjugate a verb in past tense.
 type pastHeuristic func(infinitive string) (PastTense, bool)
 
+// namedPastHeuristic pairs a past tense heuristic with its function name.
+type namedPastHeuristic struct {
+	name string
+	fn   pastHeuristic
+}
+
 // pastHeuristics is the ordered list of past tense conjugation heuristics.
-var pastHeuristics = []pastHeuristic{
+var pastHeuristics = []namedPastHeuristic{
 	// -ąść/-ąźć verbs: trząść → trząsł, prząść → prządł
-	heuristicPastAsc,
+	{"heuristicPastAsc", heuristicPastAsc},
 	// -cząć verbs: począć → począł/poczęła
-	heuristicPastCzac,
+	{"heuristicPastCzac", heuristicPastCzac},
 	// -strzyc verbs: strzyc → strzygł/strzygła
-	heuristicPastStrzyc,
+	{"heuristicPastStrzyc", heuristicPastStrzyc},
 	// -bość/-bóść verbs: bość → bódł/bodła
-	heuristicPastBosc,
+	{"heuristicPastBosc", heuristicPastBosc},
 	// -nąć verbs: two patterns based on stem
-	heuristicPastNac,
+	{"heuristicPastNac", heuristicPastNac},
 	// -ść/-źć verbs: nieść → niósł
-	heuristicPastSc,
+	{"heuristicPastSc", heuristicPastSc},
 	// -c verbs (móc, piec): móc → mógł
-	heuristicPastC,
+	{"heuristicPastC", heuristicPastC},
 	// -ować verbs: pracować → pracował
-	heuristicPastOwac,
+	{"heuristicPastOwac", heuristicPastOwac},
 	// -ywać/-iwać verbs: pokazywać → pokazywał
-	heuristicPastYwacIwac,
+	{"heuristicPastYwacIwac", heuristicPastYwacIwac},
 	// -awać verbs: dawać → dawał
-	heuristicPastAwac,
+	{"heuristicPastAwac", heuristicPastAwac},
 	// -ić verbs: robić → robił
-	heuristicPastIc,
+	{"heuristicPastIc", heuristicPastIc},
 	// -yć verbs: myć → mył
-	heuristicPastYc,
+	{"heuristicPastYc", heuristicPastYc},
 	// -uć verbs: czuć → czuł
-	heuristicPastUc,
+	{"heuristicPastUc", heuristicPastUc},
 	// -eć verbs: umieć → umiał
-	heuristicPastEc,
+	{"heuristicPastEc", heuristicPastEc},
 	// -ać verbs (fallback): czytać → czytał
-	heuristicPastAc,
+	{"heuristicPastAc", heuristicPastAc},
 }
 
 // buildPastTense creates a full past paradigm from the l-participle stem.
@@ -299,34 +352,18 @@ func applyMascSgAlternation(stem, infinitive string) string {
 
 	// Check if the infinitive itself is in the list first
 	if eToAVerbs[infinitive] {
-		return applyEToA(stem)
+		return ApplyEToA(stem)
 	}
 
 	// Then check for prefixed forms (e.g., nadwiędnąć)
 	base := extractBase(infinitive)
 	if base != infinitive && eToAVerbs[base] {
-		return applyEToA(stem)
+		return ApplyEToA(stem)
 	}
 
 	return stem
 }
 
-// applyEToA applies ę→ą or e→a alternation to the rightmost occurrence.
-func applyEToA(stem string) string {
-	runes := []rune(stem)
-	for i := len(runes) - 1; i >= 0; i-- {
-		if runes[i] == 'ę' {
-			runes[i] = 'ą'
-			return string(runes)
-		}
-		if runes[i] == 'e' {
-			runes[i] = 'a'
-			return string(runes)
-		}
-	}
-	return stem
-}
-
 // applySg3MOnlyAlternation applies alternations that ONLY affect sg3m (not sg1m/sg2m).
 // o→ó: moknąć → mókł (sg3m) but mokłem (sg1m)
 // epenthetic e: schnąć → sechł (sg3m) but schłem (sg1m)
@@ -345,25 +382,14 @@ func applySg3MOnlyAlternation(stem, infinitive string) string {
 
 	// Check o→ó (only in sg3m)
 	if oToOKreskaVerbs[infinitive] || (extractBase(infinitive) != infinitive && oToOKreskaVerbs[extractBase(infinitive)]) {
-		runes := []rune(stem)
-		for i := len(runes) - 1; i >= 0; i-- {
-			if runes[i] == 'o' {
-				runes[i] = 'ó'
-				return string(runes)
-			}
+		if alt := ApplyOToOKreska(stem); alt != stem {
+			return alt
 		}
 	}
 
-	// Check epenthetic e (only in sg3m)
+	// Check epenthetic e (only in sg3m): sch → sech
 	if epentheticEVerbs[infinitive] || (extractBase(infinitive) != infinitive && epentheticEVerbs[extractBase(infinitive)]) {
-		// Insert 'e' before the final consonant cluster
-		// sch → sech
-		runes := []rune(stem)
-		if len(runes) >= 2 {
-			// Find the consonant cluster and insert e before the last consonant
-			// For "sch": insert e before 'h' → "sech"
-			return string(runes[:len(runes)-1]) + "e" + string(runes[len(runes)-1:])
-		}
+		return InsertEpenthetic(stem)
 	}
 
 	return stem
@@ -372,15 +398,8 @@ func applySg3MOnlyAlternation(stem, infinitive string) string {
 // extractBase extracts the base verb from a potentially prefixed infinitive.
 // Returns the infinitive itself if no valid prefix is found.
 func extractBase(infinitive string) string {
-	// Try longer prefixes first to avoid false positives
-	// (e.g., "przy" before "prz", "prze" before "pr")
-	sortedPrefixes := []string{
-		"prze", "przy", "roze", "nade", "pode",
-		"roz", "nad", "pod", "obe", "ode",
-		"za", "na", "po", "do", "od", "ob", "wy",
-		"u", "s", "z", "w", "o",
-	}
-	for _, prefix := range sortedPrefixes {
+	// verbPrefixes is longest first, avoiding false positives
+	for _, prefix := range verbPrefixes {
 		if strings.HasPrefix(infinitive, prefix) {
 			candidate := infinitive[len(prefix):]
 			// Only accept if the candidate is at least 4 characters (minimum: "nąć" + 1 char stem)
@@ -435,7 +454,7 @@ func buildPastTenseMixedNDrop(stemWithoutNac, infinitive string) PastTense {
 
 	// Other forms retain n with ą→ę alternation
 	mascStem := stemWithoutNac + "ną"
-	femStem := stemWithoutNac + "nę"
+	femStem := ApplyAToE(mascStem)
 
 	return PastTense{
 		Sg1M:  mascStem + "łem",
@@ -481,61 +500,22 @@ func heuristicPastAsc(infinitive string) (PastTense, bool) {
 	// -ąść verbs: trząść → trząsł/trzęsła
 	if strings.HasSuffix(infinitive, "ząść") {
 		prefix := strings.TrimSuffix(infinitive, "ząść")
-		return PastTense{
-			Sg1M:  prefix + "ząsłem",
-			Sg1F:  prefix + "zęsłam",
-			Sg2M:  prefix + "ząsłeś",
-			Sg2F:  prefix + "zęsłaś",
-			Sg3M:  prefix + "ząsł",
-			Sg3F:  prefix + "zęsła",
-			Sg3N:  prefix + "zęsło",
-			Pl1V:  prefix + "zęśliśmy",
-			Pl1NV: prefix + "zęsłyśmy",
-			Pl2V:  prefix + "zęśliście",
-			Pl2NV: prefix + "zęsłyście",
-			Pl3V:  prefix + "zęśli",
-			Pl3NV: prefix + "zęsły",
-		}, true
+		masc := prefix + "ząs"
+		return pastSpec{masc: masc, fem: ApplyAToE(masc), virile: ApplyAToE(prefix + "ząś")}.build(), true
 	}
 
 	// prząść → prządł/przędła
 	if strings.HasSuffix(infinitive, "prząść") {
 		prefix := strings.TrimSuffix(infinitive, "prząść")
-		return PastTense{
-			Sg1M:  prefix + "prządłem",
-			Sg1F:  prefix + "przędłam",
-			Sg2M:  prefix + "prządłeś",
-			Sg2F:  prefix + "przędłaś",
-			Sg3M:  prefix + "prządł",
-			Sg3F:  prefix + "przędła",
-			Sg3N:  prefix + "przędło",
-			Pl1V:  prefix + "przędliśmy",
-			Pl1NV: prefix + "przędłyśmy",
-			Pl2V:  prefix + "przędliście",
-			Pl2NV: prefix + "przędłyście",
-			Pl3V:  prefix + "przędli",
-			Pl3NV: prefix + "przędły",
-		}, true
+		masc := prefix + "prząd"
+		return pastSpec{masc: masc, fem: ApplyAToE(masc)}.build(), true
 	}
 
 	// -siąść verbs: siąść → siadł/siadła/siedli
 	if strings.HasSuffix(infinitive, "siąść") {
 		prefix := strings.TrimSuffix(infinitive, "siąść")
-		return PastTense{
-			Sg1M:  prefix + "siadłem",
-			Sg1F:  prefix + "siadłam",
-			Sg2M:  prefix + "siadłeś",
-			Sg2F:  prefix + "siadłaś",
-			Sg3M:  prefix + "siadł",
-			Sg3F:  prefix + "siadła",
-			Sg3N:  prefix + "siadło",
-			Pl1V:  prefix + "siedliśmy",
-			Pl1NV: prefix + "siadłyśmy",
-			Pl2V:  prefix + "siedliście",
-			Pl2NV: prefix + "siadłyście",
-			Pl3V:  prefix + "siedli",
-			Pl3NV: prefix + "siadły",
-		}, true
+		stem := prefix + "siad"
+		return pastSpec{stem: stem, virile: ApplyAToE(stem)}.build(), true
 	}
 
 	return PastTense{}, false
@@ -548,21 +528,8 @@ func heuristicPastCzac(infinitive string) (PastTense, bool) {
 		return PastTense{}, false
 	}
 	prefix := strings.TrimSuffix(infinitive, "cząć")
-	return PastTense{
-		Sg1M:  prefix + "cząłem",
-		Sg1F:  prefix + "częłam",
-		Sg2M:  prefix + "cząłeś",
-		Sg2F:  prefix + "częłaś",
-		Sg3M:  prefix + "czął",
-		Sg3F:  prefix + "częła",
-		Sg3N:  prefix + "częło",
-		Pl1V:  prefix + "częliśmy",
-		Pl1NV: prefix + "częłyśmy",
-		Pl2V:  prefix + "częliście",
-		Pl2NV: prefix + "częłyście",
-		Pl3V:  prefix + "częli",
-		Pl3NV: prefix + "częły",
-	}, true
+	masc := prefix + "czą"
+	return pastSpec{masc: masc, fem: ApplyAToE(masc)}.build(), true
 }
 
 // heuristicPastStrzyc handles -strzyc verbs (strzyc, ostrzyc).
@@ -686,14 +653,14 @@ func heuristicPastNac(infinitive string) (PastTense, bool) {
 	if strings.Contains(stemWithoutNac, "ą") {
 		baseStem := strings.TrimSuffix(infinitive, "ąć") // ciągn
 		mascStem := baseStem + "ą"                       // ciągną
-		femStem := baseStem + "ę"                        // ciągnę
+		femStem := ApplyAToE(mascStem)                   // ciągnę
 		return buildPastTenseWithAlternation(mascStem, femStem), true
 	}
 
 	// Default: N-retaining with ą→ę alternation (like kopnąć → kopnął/kopnęła)
 	baseStem := strings.TrimSuffix(infinitive, "ąć") // kopn
 	mascStem := baseStem + "ą"                       // kopną
-	femStem := baseStem + "ę"                        // kopnę
+	femStem := ApplyAToE(mascStem)                   // kopnę
 	return buildPastTenseWithAlternation(mascStem, femStem), true
 }
 
@@ -850,105 +817,40 @@ func heuristicPastSc(infinitive string) (PastTense, bool) {
 	// Note: ó only in sg3m, o elsewhere (miotłem not miótłem)
 	if strings.HasSuffix(infinitive, "mieść") {
 		prefix := strings.TrimSuffix(infinitive, "mieść")
-		return PastTense{
-			Sg1M:  prefix + "miotłem",
-			Sg1F:  prefix + "miotłam",
-			Sg2M:  prefix + "miotłeś",
-			Sg2F:  prefix + "miotłaś",
-			Sg3M:  prefix + "miótł",
-			Sg3F:  prefix + "miotła",
-			Sg3N:  prefix + "miotło",
-			Pl1V:  prefix + "mietliśmy",
-			Pl1NV: prefix + "miotłyśmy",
-			Pl2V:  prefix + "mietliście",
-			Pl2NV: prefix + "miotłyście",
-			Pl3V:  prefix + "mietli",
-			Pl3NV: prefix + "miotły",
-		}, true
+		stem := prefix + "miot"
+		return pastSpec{stem: stem, sg3m: ApplyOToOKreska(stem) + "ł", virile: prefix + "miet"}.build(), true
 	}
 
 	// -gnieść verbs: gnieść → gniótł/gniotła
 	// Note: ó only in sg3m, o elsewhere
 	if strings.HasSuffix(infinitive, "gnieść") {
 		prefix := strings.TrimSuffix(infinitive, "gnieść")
-		return PastTense{
-			Sg1M:  prefix + "gniotłem",
-			Sg1F:  prefix + "gniotłam",
-			Sg2M:  prefix + "gniotłeś",
-			Sg2F:  prefix + "gniotłaś",
-			Sg3M:  prefix + "gniótł",
-			Sg3F:  prefix + "gniotła",
-			Sg3N:  prefix + "gniotło",
-			Pl1V:  prefix + "gnietliśmy",
-			Pl1NV: prefix + "gniotłyśmy",
-			Pl2V:  prefix + "gnietliście",
-			Pl2NV: prefix + "gniotłyście",
-			Pl3V:  prefix + "gnietli",
-			Pl3NV: prefix + "gniotły",
-		}, true
+		stem := prefix + "gniot"
+		return pastSpec{stem: stem, sg3m: ApplyOToOKreska(stem) + "ł", virile: prefix + "gniet"}.build(), true
 	}
 
 	// -wieść verbs: wieść → wiódł/wiodła (lead)
 	// Note: ó only in sg3m, o elsewhere
 	if strings.HasSuffix(infinitive, "wieść") {
 		prefix := strings.TrimSuffix(infinitive, "wieść")
-		return PastTense{
-			Sg1M:  prefix + "wiodłem",
-			Sg1F:  prefix + "wiodłam",
-			Sg2M:  prefix + "wiodłeś",
-			Sg2F:  prefix + "wiodłaś",
-			Sg3M:  prefix + "wiódł",
-			Sg3F:  prefix + "wiodła",
-			Sg3N:  prefix + "wiodło",
-			Pl1V:  prefix + "wiedliśmy",
-			Pl1NV: prefix + "wiodłyśmy",
-			Pl2V:  prefix + "wiedliście",
-			Pl2NV: prefix + "wiodłyście",
-			Pl3V:  prefix + "wiedli",
-			Pl3NV: prefix + "wiodły",
-		}, true
+		stem := prefix + "wiod"
+		return pastSpec{stem: stem, sg3m: ApplyOToOKreska(stem) + "ł", virile: prefix + "wied"}.build(), true
 	}
 
 	// -ieść verbs (nieść type): ie→ió/io alternation
 	// Note: ó only in sg3m, o elsewhere
 	if strings.HasSuffix(infinitive, "ieść") {
 		prefix := strings.TrimSuffix(infinitive, "ieść")
-		return PastTense{
-			Sg1M:  prefix + "iosłem",
-			Sg1F:  prefix + "iosłam",
-			Sg2M:  prefix + "iosłeś",
-			Sg2F:  prefix + "iosłaś",
-			Sg3M:  prefix + "iósł",
-			Sg3F:  prefix + "iosła",
-			Sg3N:  prefix + "iosło",
-			Pl1V:  prefix + "ieśliśmy",
-			Pl1NV: prefix + "iosłyśmy",
-			Pl2V:  prefix + "ieśliście",
-			Pl2NV: prefix + "iosłyście",
-			Pl3V:  prefix + "ieśli",
-			Pl3NV: prefix + "iosły",
-		}, true
+		stem := prefix + "ios"
+		return pastSpec{stem: stem, sg3m: ApplyOToOKreska(stem) + "ł", virile: prefix + "ieś"}.build(), true
 	}
 
 	// -ieźć verbs (wieźć type): ie→ió/io alternation
 	// Note: ó only in sg3m, o elsewhere
 	if strings.HasSuffix(infinitive, "ieźć") {
 		prefix := strings.TrimSuffix(infinitive, "ieźć")
-		return PastTense{
-			Sg1M:  prefix + "iozłem",
-			Sg1F:  prefix + "iozłam",
-			Sg2M:  prefix + "iozłeś",
-			Sg2F:  prefix + "iozłaś",
-			Sg3M:  prefix + "iózł",
-			Sg3F:  prefix + "iozła",
-			Sg3N:  prefix + "iozło",
-			Pl1V:  prefix + "ieźliśmy",
-			Pl1NV: prefix + "iozłyśmy",
-			Pl2V:  prefix + "ieźliście",
-			Pl2NV: prefix + "iozłyście",
-			Pl3V:  prefix + "ieźli",
-			Pl3NV: prefix + "iozły",
-		}, true
+		stem := prefix + "ioz"
+		return pastSpec{stem: stem, sg3m: ApplyOToOKreska(stem) + "ł", virile: prefix + "ieź"}.build(), true
 	}
 
 	// -yźć verbs (gryźć type): no vowel alternation
@@ -994,9 +896,11 @@ func heuristicPastSc(infinitive string) (PastTense, bool) {
 	return PastTense{}, false
 }
 
-// heuristicPastC handles -c verbs (móc, piec, etc.).
-// móc → mógł/mogła (ó→o alternation)
-// piec → piekł/piekła
+// heuristicPastC handles -c verbs (móc, piec, strzec, tłuc, etc.).
+// The infinitive hides the velar of the stem, so it is read off the present
+// 1sg: strzegę → strzegł, biegnę → biegł, piekę → piekł. Verbs without a
+// present fall back to g after ó and k otherwise.
+// móc → mógł/mogła (ó→o alternation, ó only in sg3m)
 func heuristicPastC(infinitive string) (PastTense, bool) {
 	if !strings.HasSuffix(infinitive, "c") {
 		return PastTense{}, false
@@ -1009,47 +913,44 @@ func heuristicPastC(infinitive string) (PastTense, bool) {
 		return PastTense{}, false
 	}
 
-	// móc type: ó→o alternation, c→g (ó only in sg3m)
-	if strings.HasSuffix(infinitive, "óc") {
-		prefix := strings.TrimSuffix(infinitive, "óc")
-		return PastTense{
-			Sg1M:  prefix + "ogłem",
-			Sg1F:  prefix + "ogłam",
-			Sg2M:  prefix + "ogłeś",
-			Sg2F:  prefix + "ogłaś",
-			Sg3M:  prefix + "ógł",
-			Sg3F:  prefix + "ogła",
-			Sg3N:  prefix + "ogło",
-			Pl1V:  prefix + "ogliśmy",
-			Pl1NV: prefix + "ogłyśmy",
-			Pl2V:  prefix + "ogliście",
-			Pl2NV: prefix + "ogłyście",
-			Pl3V:  prefix + "ogli",
-			Pl3NV: prefix + "ogły",
-		}, true
+	stem := strings.TrimSuffix(infinitive, "c") // pie, strze, mó
+	last, _ := utf8.DecodeLastRuneInString(stem)
+	if !isPolishVowel(last) {
+		return PastTense{}, false
 	}
 
-	// piec type: c→k
-	if strings.HasSuffix(infinitive, "ec") {
-		stem := strings.TrimSuffix(infinitive, "c") // pie
-		return PastTense{
-			Sg1M:  stem + "kłem",
-			Sg1F:  stem + "kłam",
-			Sg2M:  stem + "kłeś",
-			Sg2F:  stem + "kłaś",
-			Sg3M:  stem + "kł",
-			Sg3F:  stem + "kła",
-			Sg3N:  stem + "kło",
-			Pl1V:  stem + "kliśmy",
-			Pl1NV: stem + "kłyśmy",
-			Pl2V:  stem + "kliście",
-			Pl2NV: stem + "kłyście",
-			Pl3V:  stem + "kli",
-			Pl3NV: stem + "kły",
-		}, true
+	velar, ok := presentVelar(infinitive)
+	if !ok {
+		velar = "k"
+		if last == 'ó' {
+			velar = "g"
+		}
 	}
 
-	return PastTense{}, false
+	// móc type: ó→o alternation everywhere but sg3m
+	if root, ok := strings.CutSuffix(stem, "ó"); ok {
+		stem := root + "o" + velar
+		return pastSpec{stem: stem, sg3m: ApplyOToOKreska(stem) + "ł"}.build(), true
+	}
+	return pastSpec{stem: stem + velar}.build(), true
+}
+
+// presentVelar returns the velar (k or g) that closes the present stem of a
+// -c verb: piekę → k, strzegę → g, biegnę → g.
+func presentVelar(infinitive string) (string, bool) {
+	present, err := ConjugatePresent(infinitive)
+	if err != nil {
+		return "", false
+	}
+	stem := strings.TrimSuffix(present[0].Sg1, "ę")
+	stem = strings.TrimSuffix(stem, "n")
+	switch {
+	case strings.HasSuffix(stem, "k"):
+		return "k", true
+	case strings.HasSuffix(stem, "g"):
+		return "g", true
+	}
+	return "", false
 }
 
 // heuristicPastIc handles -ić verbs.
@@ -0,0 +1,46 @@
+package verb
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// knownInfinitives is every verb the package has seen attested: the
+// frequency lexicon, which covers the corpus, plus the irregular tables.
+var knownInfinitives = sync.OnceValue(func() map[string]bool {
+	known := make(map[string]bool, len(lexicon())+len(irregularSpecs))
+	for _, e := range lexicon() {
+		known[e.infinitive] = true
+	}
+	for infinitive := range irregularSpecs {
+		known[infinitive] = true
+	}
+	for infinitive := range homographs {
+		known[infinitive] = true
+	}
+	return known
+})
+
+// Prefixes returns the prefixes that form an attested verb from base,
+// sorted: pisać → do, na, o, od, po, pod, prze, przy, s, w, wy, za. The
+// prefixed forms conjugate like any other verb. Prefixed iść is spelled
+// -jść (przyjść), so its prefixes are under jść. A prefixable base
+// such as brać is not expanded with every prefix the conjugator would
+// accept, only with the ones attested in the lexicon or the irregular
+// tables. Returns nil for a base with no attested prefixed forms.
+func Prefixes(base string) []string {
+	found := make(map[string]bool)
+	for infinitive := range knownInfinitives() {
+		for _, pfx := range verbPrefixes {
+			if rest, ok := strings.CutPrefix(infinitive, pfx); ok && rest == base {
+				found[pfx] = true
+			}
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	return slices.Sorted(maps.Keys(found))
+}
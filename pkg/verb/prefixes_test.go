@@ -0,0 +1,38 @@
+package verb
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPrefixes(t *testing.T) {
+	tests := []struct {
+		base string
+		want []string
+	}{
+		{"pisać", []string{"do", "na", "o", "od", "po", "pod", "prze", "przy", "s", "w", "wy", "za"}},
+		{"brać", []string{"do", "na", "o", "ode", "po", "prze", "przy", "roze", "u", "wy", "za", "ze"}},
+		{"czytać", []string{"od", "po", "prze", "wy"}},
+		{"nieznany", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.base, func(t *testing.T) {
+			if got := Prefixes(tt.base); !slices.Equal(got, tt.want) {
+				t.Errorf("Prefixes(%q) = %v, want %v", tt.base, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPrefixesConjugate checks that every prefixed form Prefixes reports
+// is a verb the package conjugates.
+func TestPrefixesConjugate(t *testing.T) {
+	for _, base := range []string{"pisać", "brać", "jść"} {
+		for _, pfx := range Prefixes(base) {
+			if _, err := ConjugatePresent(pfx + base); err != nil {
+				t.Errorf("ConjugatePresent(%q): %v", pfx+base, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package verb
+
+import "strings"
+
+// reflexiveParticle is the reflexive marker written after the infinitive:
+// bać się, śmiać się, przestraszyć się.
+const reflexiveParticle = " się"
+
+// splitReflexive strips a trailing "się" from an infinitive.
+// bać się → (bać, true), czytać → (czytać, false)
+//
+// The particle is postposed in all generated forms (boję się, bałem się,
+// banie się), so callers conjugate the base and re-append it.
+func splitReflexive(infinitive string) (string, bool) {
+	base, ok := strings.CutSuffix(infinitive, reflexiveParticle)
+	if !ok || base == "" {
+		return infinitive, false
+	}
+	return base, true
+}
+
+// withReflexive appends "się" to every form of a present tense paradigm.
+func (p PresentTense) withReflexive() PresentTense {
+	return PresentTense{
+		Sg1: p.Sg1 + reflexiveParticle,
+		Sg2: p.Sg2 + reflexiveParticle,
+		Sg3: p.Sg3 + reflexiveParticle,
+		Pl1: p.Pl1 + reflexiveParticle,
+		Pl2: p.Pl2 + reflexiveParticle,
+		Pl3: p.Pl3 + reflexiveParticle,
+	}
+}
+
+// withReflexive appends "się" to every form of a past tense paradigm.
+func (p PastTense) withReflexive() PastTense {
+	return PastTense{
+		Sg1M: p.Sg1M + reflexiveParticle, Sg1F: p.Sg1F + reflexiveParticle,
+		Sg2M: p.Sg2M + reflexiveParticle, Sg2F: p.Sg2F + reflexiveParticle,
+		Sg3M: p.Sg3M + reflexiveParticle, Sg3F: p.Sg3F + reflexiveParticle,
+		Sg3N: p.Sg3N + reflexiveParticle,
+		Pl1V: p.Pl1V + reflexiveParticle, Pl1NV: p.Pl1NV + reflexiveParticle,
+		Pl2V: p.Pl2V + reflexiveParticle, Pl2NV: p.Pl2NV + reflexiveParticle,
+		Pl3V: p.Pl3V + reflexiveParticle, Pl3NV: p.Pl3NV + reflexiveParticle,
+	}
+}
+
+// conjugatePastReflexive conjugates the base of a reflexive verb
+// and re-appends "się": bać się → bałem się, bałam się...
+func conjugatePastReflexive(base string) ([]PastParadigm, error) {
+	paradigms, err := ConjugatePast(base)
+	if err != nil {
+		return nil, err
+	}
+	for i := range paradigms {
+		paradigms[i].PastTense = paradigms[i].withReflexive()
+	}
+	return paradigms, nil
+}
+
+// verbalNounReflexive derives the verbal noun of a reflexive verb,
+// keeping "się": śmiać się → śmianie się.
+func verbalNounReflexive(base string) ([]string, error) {
+	forms, err := VerbalNoun(base)
+	if err != nil {
+		return nil, err
+	}
+	reflexive := make([]string, len(forms))
+	for i, f := range forms {
+		reflexive[i] = f + reflexiveParticle
+	}
+	return reflexive, nil
+}
@@ -0,0 +1,79 @@
+package verb
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestConjugatePresentReflexive(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       PresentTense
+	}{
+		{
+			infinitive: "bać się",
+			want: PresentTense{
+				Sg1: "boję się", Sg2: "boisz się", Sg3: "boi się",
+				Pl1: "boimy się", Pl2: "boicie się", Pl3: "boją się",
+			},
+		},
+		{
+			infinitive: "śmiać się",
+			want: PresentTense{
+				Sg1: "śmieję się", Sg2: "śmiejesz się", Sg3: "śmieje się",
+				Pl1: "śmiejemy się", Pl2: "śmiejecie się", Pl3: "śmieją się",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			paradigms, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got := paradigms[0].PresentTense; got != tt.want {
+				t.Errorf("ConjugatePresent(%q) =\n%+v\nwant:\n%+v", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConjugatePastReflexive(t *testing.T) {
+	paradigms, err := ConjugatePast("przestraszyć się")
+	if err != nil {
+		t.Fatalf("ConjugatePast(przestraszyć się) error: %v", err)
+	}
+	got := paradigms[0]
+	if got.Sg1M != "przestraszyłem się" || got.Sg3F != "przestraszyła się" || got.Pl3V != "przestraszyli się" {
+		t.Errorf("ConjugatePast(przestraszyć się) = %+v", got.PastTense)
+	}
+}
+
+func TestVerbalNounReflexive(t *testing.T) {
+	got, err := VerbalNoun("śmiać się")
+	if err != nil {
+		t.Fatalf("VerbalNoun(śmiać się) error: %v", err)
+	}
+	if !slices.Equal(got, []string{"śmianie się"}) {
+		t.Errorf("VerbalNoun(śmiać się) = %v, want [śmianie się]", got)
+	}
+}
+
+func TestSplitReflexive(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantBase   string
+		wantOK     bool
+	}{
+		{"bać się", "bać", true},
+		{"czytać", "czytać", false},
+		{" się", " się", false},
+	}
+	for _, tt := range tests {
+		base, ok := splitReflexive(tt.infinitive)
+		if base != tt.wantBase || ok != tt.wantOK {
+			t.Errorf("splitReflexive(%q) = %q, %v; want %q, %v", tt.infinitive, base, ok, tt.wantBase, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package verb
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Format selects how WriteFormat renders a paradigm.
+type Format int
+
+const (
+	FormatText     Format = iota // aligned "label form" lines
+	FormatMarkdown               // a person × number table
+	FormatCSV                    // one person,number[,gender],form row per form
+)
+
+// String returns the short name of the format, as accepted by ParseFormat.
+func (f Format) String() string {
+	switch f {
+	case FormatText:
+		return "text"
+	case FormatMarkdown:
+		return "markdown"
+	case FormatCSV:
+		return "csv"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// ParseFormat parses a format name: "text", "markdown" or "csv".
+func ParseFormat(s string) (Format, error) {
+	switch s {
+	case "text":
+		return FormatText, nil
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	case "csv":
+		return FormatCSV, nil
+	default:
+		return 0, fmt.Errorf("unknown format: %q (want text, markdown or csv)", s)
+	}
+}
+
+// WriteTo writes the paradigm as text, one "label form" line per slot
+// with Polish labels, so a Paradigm is an io.WriterTo.
+func (p Paradigm) WriteTo(w io.Writer) (int64, error) {
+	return p.WriteFormat(w, FormatText)
+}
+
+// WriteFormat writes the paradigm to w in the given format and returns
+// the number of bytes written:
+//
+//	FormatText:     "ja     czytam" lines, labels aligned
+//	FormatMarkdown: | | sg | pl | with a row per person
+//	FormatCSV:      person,number,form with a row per form: 1,sg,czytam
+func (p Paradigm) WriteFormat(w io.Writer, format Format) (int64, error) {
+	return p.PresentTense.Table(PolishLabels).writeFormat(w, format)
+}
+
+// WriteTo writes the paradigm as text, like Paradigm.WriteTo.
+func (p PastParadigm) WriteTo(w io.Writer) (int64, error) {
+	return p.WriteFormat(w, FormatText)
+}
+
+// WriteFormat writes the paradigm like Paradigm.WriteFormat. The
+// Markdown table has a column per number and gender (sg.m, sg.f...)
+// and the CSV a gender column: person,number,gender,form.
+func (p PastParadigm) WriteFormat(w io.Writer, format Format) (int64, error) {
+	return p.PastTense.Table(PolishLabels).writeFormat(w, format)
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// writeFormat renders the table in the given format.
+func (t Table) writeFormat(w io.Writer, format Format) (int64, error) {
+	cw := &countingWriter{w: w}
+	var err error
+	switch format {
+	case FormatText:
+		err = t.writeText(cw)
+	case FormatMarkdown:
+		err = t.writeMarkdown(cw)
+	case FormatCSV:
+		err = t.writeCSV(cw)
+	default:
+		err = fmt.Errorf("unknown format: %v", format)
+	}
+	return cw.n, err
+}
+
+// writeText writes one line per row, the forms aligned after the labels.
+func (t Table) writeText(w io.Writer) error {
+	width := 0
+	for _, row := range t.Rows {
+		width = max(width, utf8.RuneCountInString(row.Label))
+	}
+	for _, row := range t.Rows {
+		pad := width - utf8.RuneCountInString(row.Label) + 1
+		if _, err := fmt.Fprintf(w, "%s%s%s\n", row.Label, strings.Repeat(" ", pad), strings.Join(row.Forms, ", ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMarkdown writes a table with a row per person and a column per
+// number (and gender), leaving slots the paradigm lacks (1sg.n) empty.
+func (t Table) writeMarkdown(w io.Writer) error {
+	var columns []string
+	cells := make(map[[2]string]string)
+	for _, row := range t.Rows {
+		col := slotColumn(row)
+		if _, ok := cells[[2]string{"", col}]; !ok {
+			cells[[2]string{"", col}] = col
+			columns = append(columns, col)
+		}
+		cells[[2]string{strconv.Itoa(int(row.Person)), col}] = strings.Join(row.Forms, ", ")
+	}
+
+	var b strings.Builder
+	b.WriteString("| |")
+	for _, col := range columns {
+		b.WriteString(" " + col + " |")
+	}
+	b.WriteString("\n|---|")
+	b.WriteString(strings.Repeat("---|", len(columns)))
+	b.WriteString("\n")
+	for _, person := range []Person{First, Second, Third} {
+		p := strconv.Itoa(int(person))
+		b.WriteString("| " + p + " |")
+		for _, col := range columns {
+			if cell := cells[[2]string{p, col}]; cell != "" {
+				b.WriteString(" " + cell)
+			}
+			b.WriteString(" |")
+		}
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeCSV writes a header and one row per form. The gender column is
+// only written for tenses that inflect for it.
+func (t Table) writeCSV(w io.Writer) error {
+	gendered := t.Tense == Past
+	cw := csv.NewWriter(w)
+	header := []string{"person", "number", "form"}
+	if gendered {
+		header = []string{"person", "number", "gender", "form"}
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range t.Rows {
+		for _, form := range row.Forms {
+			record := []string{strconv.Itoa(int(row.Person)), numberAbbrev(row.Number)}
+			if gendered {
+				record = append(record, genderAbbrev(row.Gender))
+			}
+			if err := cw.Write(append(record, form)); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// slotColumn names the Markdown column of a row: sg, pl, or with a
+// gender sg.m, pl.nv.
+func slotColumn(row TableRow) string {
+	if row.Gender == 0 {
+		return numberAbbrev(row.Number)
+	}
+	return numberAbbrev(row.Number) + "." + genderAbbrev(row.Gender)
+}
+
+// numberAbbrev returns sg or pl.
+func numberAbbrev(n Number) string {
+	if n == Plural {
+		return "pl"
+	}
+	return "sg"
+}
+
+// genderAbbrev returns m, f, n, v or nv, as in the abbreviated labels.
+func genderAbbrev(g Gender) string {
+	switch g {
+	case Masculine:
+		return "m"
+	case Feminine:
+		return "f"
+	case Neuter:
+		return "n"
+	case MascPersonal:
+		return "v"
+	case NonMascPersonal:
+		return "nv"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,102 @@
+package verb
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "rewrite the testdata/golden files")
+
+// checkGolden compares got with a file under testdata/golden, rewriting
+// it instead with -update-golden.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", "golden", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s mismatch:\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+func TestWriteFormat(t *testing.T) {
+	present, err := ConjugatePresent("czytać")
+	if err != nil {
+		t.Fatal(err)
+	}
+	past, err := ConjugatePast("czytać")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		format Format
+		ext    string
+	}{
+		{FormatText, "txt"},
+		{FormatMarkdown, "md"},
+		{FormatCSV, "csv"},
+	} {
+		t.Run(tt.format.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			n, err := present[0].WriteFormat(&buf, tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != int64(buf.Len()) {
+				t.Errorf("present: wrote %d bytes, reported %d", buf.Len(), n)
+			}
+			checkGolden(t, "czytac_present."+tt.ext, buf.Bytes())
+
+			buf.Reset()
+			n, err = past[0].WriteFormat(&buf, tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != int64(buf.Len()) {
+				t.Errorf("past: wrote %d bytes, reported %d", buf.Len(), n)
+			}
+			checkGolden(t, "czytac_past."+tt.ext, buf.Bytes())
+		})
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	present, err := ConjugatePresent("czytać")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var text, viaTo bytes.Buffer
+	present[0].WriteFormat(&text, FormatText)
+	present[0].WriteTo(&viaTo)
+	if text.String() != viaTo.String() {
+		t.Errorf("WriteTo = %q, want the text format %q", viaTo.String(), text.String())
+	}
+
+	if _, err := present[0].WriteFormat(&text, Format(9)); err == nil {
+		t.Error("WriteFormat with an unknown format: expected error")
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range []Format{FormatText, FormatMarkdown, FormatCSV} {
+		got, err := ParseFormat(f.String())
+		if err != nil || got != f {
+			t.Errorf("ParseFormat(%q) = %v, %v, want %v", f.String(), got, err, f)
+		}
+	}
+	if _, err := ParseFormat("html"); err == nil {
+		t.Error("ParseFormat(html): expected error")
+	}
+}
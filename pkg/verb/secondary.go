@@ -0,0 +1,279 @@
+package verb
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ImperfectiveCandidate is one possible imperfective partner of a
+// perfective verb, with how much to trust it.
+type ImperfectiveCandidate struct {
+	Infinitive string
+	Confidence Confidence
+}
+
+// imperfectivePartners lists perfectives whose imperfective partner is
+// suppletive or irregular, so no suffix rule derives it.
+var imperfectivePartners = map[string]string{
+	"wziąć":        "brać",
+	"powiedzieć":   "mówić",
+	"zobaczyć":     "widzieć",
+	"położyć":      "kłaść",
+	"kupić":        "kupować",
+	"zacząć":       "zaczynać",
+	"otworzyć":     "otwierać",
+	"zamknąć":      "zamykać",
+	"znaleźć":      "znajdować",
+	"spotkać":      "spotykać",
+	"pomóc":        "pomagać",
+	"umrzeć":       "umierać",
+	"obejrzeć":     "oglądać",
+	"usiąść":       "siadać",
+	"wrócić":       "wracać",
+	"zapomnieć":    "zapominać",
+	"odpowiedzieć": "odpowiadać",
+	"zostać":       "zostawać",
+	"westchnąć":    "wzdychać",
+}
+
+// prefixedPartners maps bases whose prefixed perfectives form their
+// imperfective from another stem: przyjść → przychodzić, sprzedać →
+// sprzedawać, przyjąć → przyjmować, zabrać → zabierać, zasnąć →
+// zasypiać.
+var prefixedPartners = map[string]string{
+	"brać":   "bierać",
+	"jść":    "chodzić",
+	"dać":    "dawać",
+	"stać":   "stawać",
+	"znać":   "znawać",
+	"jechać": "jeżdżać",
+	"nieść":  "nosić",
+	"wieźć":  "wozić",
+	"wieść":  "wodzić",
+	"biec":   "biegać",
+	"kryć":   "krywać",
+	"bić":    "bijać",
+	"pić":    "pijać",
+	"żyć":    "żywać",
+	"jąć":    "jmować",
+	"ciąć":   "cinać",
+	"mrzeć":  "mierać",
+	"trzeć":  "cierać",
+	"kupić":  "kupować",
+	"snąć":   "sypiać",
+}
+
+// SecondaryImperfective returns the most likely imperfective partner of a
+// perfective verb: przepisać → przepisywać, zrobić → robić, kupić →
+// kupować. See SecondaryImperfectives for how it is derived.
+func SecondaryImperfective(perfective string) (string, error) {
+	candidates, err := SecondaryImperfectives(perfective)
+	if err != nil {
+		return "", err
+	}
+	return candidates[0].Infinitive, nil
+}
+
+// SecondaryImperfectives returns the possible imperfective partners of a
+// perfective verb, most likely first.
+//
+// The derivation is best-effort. Suppletive and irregular pairs come from
+// a table with High confidence:
+//
+//	wziąć/brać, powiedzieć/mówić, zobaczyć/widzieć, położyć/kłaść,
+//	kupić/kupować, zacząć/zaczynać, otworzyć/otwierać, zamknąć/zamykać,
+//	znaleźć/znajdować, spotkać/spotykać, pomóc/pomagać, umrzeć/umierać,
+//	obejrzeć/oglądać, usiąść/siadać, wrócić/wracać, zapomnieć/zapominać,
+//	odpowiedzieć/odpowiadać, westchnąć/wzdychać, zostać/zostawać
+//
+// as are prefixed forms of a few bases that switch stem: przyjść/
+// przychodzić, sprzedać/sprzedawać, zabić/zabijać, przyjąć/przyjmować,
+// zabrać/zabierać, zebrać/zbierać, zasnąć/zasypiać.
+// Other verbs go through the productive suffix rules:
+//   - -ać → -ywać (-iwać after k, g): przepisać → przepisywać
+//   - -ować → -owywać: wydrukować → wydrukowywać
+//   - -ić/-yć → -iać/-ać, with the consonant alternations and root o/ó → a:
+//     zamienić → zamieniać, przerobić → przerabiać, zaprosić → zapraszać
+//   - -nąć → -ać: wyciągnąć → wyciągać
+//
+// and, for a prefixed verb, the bare base the prefix only perfectivized:
+// zrobić → robić, napisać → pisać. The bare base is preferred after the
+// prefixes z-, s-, ze-, u-, po- and na-, unless the derived form is
+// attested in the lexicon, and is then returned alone. Rule-based
+// candidates rank Medium at best; the -nąć rule and the o/ó → a
+// alternation often make non-words (krzyknąć → krzykać), so their
+// unattested output ranks Low. An error is returned for a verb known to
+// be imperfective or one no rule applies to.
+func SecondaryImperfectives(perfective string) ([]ImperfectiveCandidate, error) {
+	perfective, _ = normalizeInfinitive(perfective)
+	if err := checkInfinitive(perfective); err != nil {
+		return nil, err
+	}
+	base, reflexive := splitReflexive(perfective)
+	candidates := partnerCandidates(base)
+	if candidates == nil {
+		if a, err := Aspect(perfective); err == nil && a == Imperfective {
+			return nil, fmt.Errorf("%q is already imperfective", perfective)
+		}
+		candidates = imperfectiveCandidates(base)
+	}
+	if len(candidates) == 0 {
+		return nil, &ConjugationError{Infinitive: perfective, Form: "secondary imperfective"}
+	}
+	if reflexive {
+		for i := range candidates {
+			candidates[i].Infinitive += reflexiveParticle
+		}
+	}
+	return candidates, nil
+}
+
+// partnerCandidates returns the partner of a non-reflexive perfective
+// from the override tables, or nil when it has none there.
+func partnerCandidates(perfective string) []ImperfectiveCandidate {
+	if partner, ok := imperfectivePartners[perfective]; ok {
+		return []ImperfectiveCandidate{{partner, High}}
+	}
+	for _, base := range slices.Sorted(maps.Keys(prefixedPartners)) {
+		prefix, ok := strings.CutSuffix(perfective, base)
+		if ok && prefix != "" && canStripPrefixes(prefix, verbPrefixes) {
+			partner := prefixedPartners[base]
+			// ze-/ode- lose the vowel before the new stem: zebrać → zbierać
+			prefix = stripEpentheticVowelForPresent(prefix, partner)
+			return []ImperfectiveCandidate{{prefix + partner, High}}
+		}
+	}
+	return nil
+}
+
+// emptyPrefixes mostly perfectivize without changing the meaning, so a
+// verb bearing one usually pairs with its bare base: zrobić/robić,
+// napisać/pisać, umyć/myć. Other prefixes add meaning and take a derived
+// imperfective: przepisać/przepisywać.
+var emptyPrefixes = []string{"z", "s", "ze", "u", "po", "na"}
+
+// imperfectiveCandidates derives the candidates for a non-reflexive
+// perfective by rule, most likely first. A derived form attested in the
+// lexicon wins; otherwise the bare base wins after an empty prefix and
+// the derived form after any other.
+func imperfectiveCandidates(perfective string) []ImperfectiveCandidate {
+	known := func(infinitive string) bool {
+		return knownInfinitives()[infinitive] || knownInfinitives()[infinitive+reflexiveParticle]
+	}
+
+	var derived []ImperfectiveCandidate
+	attested := false
+	for _, c := range deriveImperfectives(perfective) {
+		if known(c.Infinitive) {
+			attested = true
+			c.Confidence = Medium
+		}
+		derived = append(derived, c)
+	}
+
+	var base *ImperfectiveCandidate
+	for _, prefix := range verbPrefixes {
+		rest, ok := strings.CutPrefix(perfective, prefix)
+		if !ok || !known(rest) {
+			continue
+		}
+		if a, err := Aspect(rest); err != nil || a != Imperfective {
+			continue
+		}
+		base = &ImperfectiveCandidate{rest, Low}
+		if !attested && slices.Contains(emptyPrefixes, prefix) {
+			// The unattested derived forms are noise here: zrobić →
+			// zrabiać, umyć → umać.
+			base.Confidence = Medium
+			return []ImperfectiveCandidate{*base}
+		}
+		break
+	}
+	if base != nil {
+		return append(derived, *base)
+	}
+	return derived
+}
+
+// deriveImperfectives applies the productive suffix rules to a perfective.
+// Output of the -nąć rule or with the root vowel alternated is Low, the
+// rest Medium.
+func deriveImperfectives(perfective string) []ImperfectiveCandidate {
+	if stem, ok := strings.CutSuffix(perfective, "ować"); ok {
+		return []ImperfectiveCandidate{{stem + "owywać", Medium}}
+	}
+	if stem, ok := strings.CutSuffix(perfective, "nąć"); ok {
+		return []ImperfectiveCandidate{{stem + "ać", Low}}
+	}
+	if stem, ok := strings.CutSuffix(perfective, "oić"); ok {
+		return []ImperfectiveCandidate{{stem + "ajać", Medium}} // uspokoić → uspokajać
+	}
+	if stem, ok := strings.CutSuffix(perfective, "ić"); ok {
+		return []ImperfectiveCandidate{withRootVowel(softStemImperfective(stem))}
+	}
+	if stem, ok := strings.CutSuffix(perfective, "yć"); ok {
+		return []ImperfectiveCandidate{withRootVowel(stem + "ać")}
+	}
+	if stem, ok := strings.CutSuffix(perfective, "ać"); ok {
+		if strings.HasSuffix(stem, "k") || strings.HasSuffix(stem, "g") {
+			return []ImperfectiveCandidate{{stem + "iwać", Medium}}
+		}
+		return []ImperfectiveCandidate{{stem + "ywać", Medium}}
+	}
+	return nil
+}
+
+// withRootVowel applies alternateRootVowel to an -ać imperfective, at Low
+// confidence when it changed the vowel.
+func withRootVowel(s string) ImperfectiveCandidate {
+	if a := alternateRootVowel(s); a != s {
+		return ImperfectiveCandidate{a, Low}
+	}
+	return ImperfectiveCandidate{s, Medium}
+}
+
+// softStemImperfective builds the -ać imperfective stem of an -ić verb:
+// labials and n keep the softening i (zamienić → zamieniać), l, c and dz
+// drop it (ustalić → ustalać), and s, z, ść harden (zaprosić → zapraszać,
+// wyrazić → wyrażać, wypuścić → wypuszczać).
+func softStemImperfective(stem string) string {
+	switch {
+	case strings.HasSuffix(stem, "śc"):
+		return strings.TrimSuffix(stem, "śc") + "szczać"
+	case strings.HasSuffix(stem, "źdz"):
+		return strings.TrimSuffix(stem, "źdz") + "żdżać"
+	case strings.HasSuffix(stem, "dz"), strings.HasSuffix(stem, "c"), strings.HasSuffix(stem, "l"):
+		return stem + "ać"
+	case strings.HasSuffix(stem, "s"):
+		return strings.TrimSuffix(stem, "s") + "szać"
+	case strings.HasSuffix(stem, "z"):
+		return strings.TrimSuffix(stem, "z") + "żać"
+	default:
+		return stem + "iać"
+	}
+}
+
+// alternateRootVowel turns o or ó into a when it is the last vowel before
+// the imperfective suffix: przerobić → przerabiać, wrócić → wracać.
+func alternateRootVowel(s string) string {
+	suffix := ""
+	if stem, ok := strings.CutSuffix(s, "ać"); ok {
+		s, suffix = stem, "ać"
+	}
+	if stem, ok := strings.CutSuffix(s, "i"); ok {
+		s, suffix = stem, "i"+suffix
+	}
+	runes := []rune(s)
+	for i := len(runes) - 1; i >= 0; i-- {
+		if !isPolishVowel(runes[i]) {
+			continue
+		}
+		if runes[i] == 'o' || runes[i] == 'ó' {
+			runes[i] = 'a'
+		}
+		break
+	}
+	return string(runes) + suffix
+}
@@ -0,0 +1,103 @@
+package verb
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSecondaryImperfective(t *testing.T) {
+	tests := []struct {
+		perfective string
+		want       string
+	}{
+		// Productive suffix rules
+		{"przepisać", "przepisywać"},
+		{"wykonać", "wykonywać"},
+		{"wydrukować", "wydrukowywać"},
+		{"zamienić", "zamieniać"},
+		{"ustalić", "ustalać"},
+		{"przerobić", "przerabiać"}, // root o → a
+		{"zaprosić", "zapraszać"},
+		{"wypuścić", "wypuszczać"},
+		{"wyłączyć", "wyłączać"},
+		{"wyciągnąć", "wyciągać"},
+		{"uspokoić się", "uspokajać się"},
+
+		// Bare base after an empty prefix
+		{"zrobić", "robić"},
+		{"napisać", "pisać"},
+		{"umyć", "myć"},
+
+		// Override tables
+		{"kupić", "kupować"},
+		{"wziąć", "brać"},
+		{"powiedzieć", "mówić"},
+		{"przyjść", "przychodzić"},
+		{"sprzedać", "sprzedawać"},
+		{"przyjąć", "przyjmować"},
+		{"zabrać", "zabierać"},
+		{"zebrać", "zbierać"},
+		{"odebrać", "odbierać"},
+		{"zasnąć", "zasypiać"},
+		{"usnąć", "usypiać"},
+		{"przysnąć", "przysypiać"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.perfective, func(t *testing.T) {
+			got, err := SecondaryImperfective(tt.perfective)
+			if err != nil {
+				t.Fatalf("SecondaryImperfective(%q) error: %v", tt.perfective, err)
+			}
+			if got != tt.want {
+				t.Errorf("SecondaryImperfective(%q) = %q, want %q", tt.perfective, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecondaryImperfectives(t *testing.T) {
+	got, err := SecondaryImperfectives("przerobić")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ImperfectiveCandidate{{"przerabiać", Medium}, {"robić", Low}}
+	if !slices.Equal(got, want) {
+		t.Errorf("SecondaryImperfectives(przerobić) = %v, want %v", got, want)
+	}
+
+	// After an empty prefix the unattested derived form is dropped
+	got, err = SecondaryImperfectives("zrobić")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []ImperfectiveCandidate{{"robić", Medium}}; !slices.Equal(got, want) {
+		t.Errorf("SecondaryImperfectives(zrobić) = %v, want %v", got, want)
+	}
+
+	// The -nąć rule is unreliable: krzyknąć pairs with krzyczeć
+	got, err = SecondaryImperfectives("krzyknąć")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []ImperfectiveCandidate{{"krzykać", Low}}; !slices.Equal(got, want) {
+		t.Errorf("SecondaryImperfectives(krzyknąć) = %v, want %v", got, want)
+	}
+
+	got, err = SecondaryImperfectives("wziąć")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []ImperfectiveCandidate{{"brać", High}}; !slices.Equal(got, want) {
+		t.Errorf("SecondaryImperfectives(wziąć) = %v, want %v", got, want)
+	}
+
+	for _, imperfective := range []string{"pisać", "przepisywać"} {
+		if _, err := SecondaryImperfectives(imperfective); err == nil {
+			t.Errorf("SecondaryImperfectives(%q) should fail for an imperfective", imperfective)
+		}
+	}
+	if _, err := SecondaryImperfectives("pies"); err == nil {
+		t.Error("SecondaryImperfectives(pies) should fail for a non-infinitive")
+	}
+}
@@ -18,67 +18,60 @@ type verbSpec struct {
 var irregularSpecs map[string]verbSpec
 
 // prefixableVerbs lists verbs that can take prefixes productively.
-// Used by the lookup functions for prefix-stripping.
+// Used by the lookup functions for prefix-stripping, so every base must
+// have an entry in irregularSpecs; the grouping below records which tense
+// first needed it, but a listed base is prefixable in every tense it has
+// irregular data for. Homographs use expandableHomographs instead.
 var prefixableVerbs = map[string]bool{
 	// Present tense prefixable
-	"pisać": true, "brać": true, "jechać": true, "dać": true,
+	"brać": true, "jechać": true, "dać": true,
 	"wziąć": true, "iść": true, "jeść": true, "prać": true,
-	"czesać": true, "kasać": true, "ciosać": true, "ciesać": true,
-	"skakać": true, "płakać": true, "wiązać": true, "kazać": true,
-	"mazać": true, "lizać": true, "kołysać": true, "krzesać": true,
+	"kasać": true, "ciosać": true, "ciesać": true, "krzesać": true,
 	"naleźć": true, "spać": true, "bać": true, "dziać": true,
 	"podobać": true,
 	// Monosyllabic verbs
 	"bić": true, "lić": true, "pić": true, "żyć": true, "myć": true,
 	"ryć": true, "szyć": true, "wyć": true, "kryć": true,
 	// Other prefixable present bases
-	"pomnieć": true, "mrzeć": true, "ciec": true, "woleć": true,
-	"jąć": true, "cząć": true, "patrzeć": true,
+	"pomnieć": true, "mrzeć": true, "ciec": true,
+	"jąć": true, "cząć": true,
 	"rwać": true, "zwać": true, "dbać": true, "śmiać": true,
-	"cierpieć": true, "wisieć": true, "jeździć": true,
-	"pachnieć": true, "strzec": true, "chować": true,
-	"grzmieć": true, "szumieć": true, "tłumieć": true,
-	"okazać": true, "karać": true, "kraść": true, "kłaść": true,
+	"wisieć": true, "jeździć": true,
+	"pachnieć": true, "strzec": true,
 	"lać": true, "grześć": true, "przeć": true, "wrzeć": true,
 	"śnić": true, "rzec": true, "wiać": true, "krajać": true,
-	"słać": true, "nająć": true, "tłuc": true, "pleść": true, "kląć": true,
+	"nająć": true, "tłuc": true, "pleść": true, "kląć": true,
 	"żreć": true, "chwiać": true,
-	"starzeć": true, "gorzeć": true, "dorzeć": true, "dobrzeć": true,
 	"czcić": true, "kpić": true, "ulec": true, "wściec": true,
-	"dojrzeć": true, "boleć": true, "swędzieć": true,
-	"tajać": true, "ćpać": true, "wić": true,
+	"tajać": true, "ćpać": true, "wić": true, "motać": true,
 	"bimbać": true, "gabać": true, "chybać": true, "gnić": true,
 	"siać": true, "gibać": true, "siorbać": true, "stąpać": true,
 	"pchlać": true, "rychlać": true, "gdybać": true,
 	"użyć": true,
-	// Inchoative -eć verbs
-	"chorzeć": true, "tężeć": true, "dumieć": true, "goreć": true,
-	"śniedzieć": true, "srebrzeć": true, "cukrzeć": true,
 	// Additional prefixable bases
-	"łajać": true, "bajać": true, "pierdzieć": true, "skomleć": true,
+	"łajać": true,
 	"strzeliwać": true, "myśliwać": true, "boliwać": true, "mgliwać": true,
 	"kpać": true, "tlić": true, "clić": true, "dlić": true,
 	"kasłać": true, "mieszywać": true, "supływać": true, "bazgrywać": true,
 	"podobywać": true, "cierpać": true, "siąpać": true, "tyrpać": true,
 	"ściubać": true, "ślipać": true, "bombać": true,
-	"szedzieć": true, "piać": true, "spiać": true, "skuliwać": true,
-	"kaszliwać": true, "pyskiwać": true, "ziajać": true,
-	"śmierdzieć": true,
+	"piać": true, "spiać": true, "skuliwać": true,
 
 	// Past tense prefixable
 	"być": true, "ciąć": true,
 	"dąć": true, "giąć": true, "piąć": true, "miąć": true,
 	"żąć": true,
 	"siąść": true, "paść": true, "prząść": true,
+	"kraść": true, "kłaść": true,
 	"gryźć": true, "leźć": true, "wieźć": true, "nieść": true,
 	"trzeć": true, "drzeć": true,
 	"stać": true, "mieć": true,
 	"wiedzieć": true, "siedzieć": true, "widzieć": true,
-	"biec": true, "lec": true, "piec": true, "wlec": true,
+	"lec": true, "wlec": true,
 	"rosnąć": true, "rość": true, "schnąć": true, "przysięgnąć": true,
 	"umrzeć": true,
 	"mleć": true, "pleć": true, "żec": true,
-	"musieć": true, "słyszeć": true, "móc": true,
+	"musieć": true, "słyszeć": true,
 
 	// Verbal noun prefixable
 	"tyć": true,
@@ -87,18 +80,15 @@ var prefixableVerbs = map[string]bool{
 	"mierzić": true, "gałęzić": true, "więzić": true,
 	"francuzić": true, "lesić": true, "tłamsić": true,
 	"chrzęścieć": true,
-	"gzić": true,
-	"siec": true, "strzyc": true, "prząc": true, "siąc": true, "ląc": true,
+	"strzyc": true, "prząc": true, "siąc": true, "ląc": true,
 	"bość": true, "bóść": true, "gnieść": true,
 	"mieść": true, "róść": true, "trząść": true,
 	"jść": true, "nijść": true, "niść": true,
 	"grząźć": true, "liźć": true,
 	"słonić": true,
-	"wieść": true, "oblec": true, "sieść": true,
-	"pomóc": true, "domóc": true,
+	"wieść": true, "sieść": true,
 	"upaść": true, "podnieść": true,
-	"przysiąc": true, "niemóc": true,
-	"postrzec": true, "wsiąść": true,
+	"przysiąc": true, "wsiąść": true,
 	"strząść": true,
 	// Compound prefix bases for VN
 	"zbyć": true, "dobyć": true, "pożyć": true,
@@ -107,6 +97,60 @@ var prefixableVerbs = map[string]bool{
 
 func init() {
 	irregularSpecs = buildIrregularSpecs()
+	presentIndex = buildLookupIndex(func(s verbSpec) bool { return s.present != nil })
+	pastIndex = buildLookupIndex(func(s verbSpec) bool { return s.past != nil })
+	vnIndex = buildLookupIndex(func(s verbSpec) bool { return s.verbalNoun != nil })
+}
+
+// Precomputed irregular lookups: every irregular verb and every prefixed
+// form of a prefixable base, per tense. Scanning verbPrefixes on each call
+// adds up over long word lists; the indexes answer with one map read and,
+// being read-only after init, are safe for concurrent use.
+var presentIndex, pastIndex, vnIndex map[string]indexedSpec
+
+// indexedSpec is a lookup index entry: the spec found and the prefix
+// stripped to find it.
+type indexedSpec struct {
+	spec   verbSpec
+	prefix string
+}
+
+// buildLookupIndex indexes the verbs that have data for one tense: direct
+// entries first, then every prefixed form of a prefixable base, resolved
+// with stripKnownPrefix as the scan does.
+func buildLookupIndex(has func(verbSpec) bool) map[string]indexedSpec {
+	index := make(map[string]indexedSpec)
+	for infinitive, s := range irregularSpecs {
+		if has(s) {
+			index[infinitive] = indexedSpec{spec: s}
+		}
+	}
+	for _, pfx := range verbPrefixes {
+		for base := range prefixableVerbs {
+			infinitive := pfx + base
+			if _, ok := index[infinitive]; ok {
+				continue
+			}
+			prefix, base := stripKnownPrefix(infinitive)
+			if s, ok := irregularSpecs[base]; ok && has(s) {
+				index[infinitive] = indexedSpec{spec: s, prefix: prefix}
+			}
+		}
+	}
+	return index
+}
+
+// stripKnownPrefix splits a prefixed form of a prefixable base into the
+// prefix and the base, trying the longest prefix first: rozebrać → roze +
+// brać, not roz + ebrać. It returns "" and the infinitive unchanged when
+// no prefix leaves a prefixable base.
+func stripKnownPrefix(infinitive string) (prefix, base string) {
+	for _, pfx := range verbPrefixes {
+		if rest, ok := strings.CutPrefix(infinitive, pfx); ok && prefixableVerbs[rest] {
+			return pfx, rest
+		}
+	}
+	return "", infinitive
 }
 
 // buildIrregularSpecs merges the three irregular maps into a unified verbSpec map.
@@ -141,20 +185,24 @@ func buildIrregularSpecs() map[string]verbSpec {
 // lookupIrregularPresent looks up a verb's present tense spec in the unified map,
 // including prefix-stripping for known prefixable bases.
 func lookupIrregularPresent(infinitive string) (ps presentSpec, prefix string, found bool) {
+	e, ok := presentIndex[infinitive]
+	if !ok {
+		return presentSpec{}, "", false
+	}
+	return *e.spec.present, e.prefix, true
+}
+
+// scanIrregularPresent is lookupIrregularPresent without the index.
+func scanIrregularPresent(infinitive string) (ps presentSpec, prefix string, found bool) {
 	// Direct lookup first
 	if s, ok := irregularSpecs[infinitive]; ok && s.present != nil {
 		return *s.present, "", true
 	}
 
-	// Try stripping prefixes to find base irregular verb
-	for _, pfx := range verbPrefixes {
-		if len(infinitive) > len(pfx) && infinitive[:len(pfx)] == pfx {
-			base := infinitive[len(pfx):]
-			if prefixableVerbs[base] {
-				if s, ok := irregularSpecs[base]; ok && s.present != nil {
-					return *s.present, pfx, true
-				}
-			}
+	// Strip a prefix to find the base irregular verb
+	if pfx, base := stripKnownPrefix(infinitive); pfx != "" {
+		if s, ok := irregularSpecs[base]; ok && s.present != nil {
+			return *s.present, pfx, true
 		}
 	}
 
@@ -164,20 +212,24 @@ func lookupIrregularPresent(infinitive string) (ps presentSpec, prefix string, f
 // lookupIrregularPast looks up a verb's past tense spec in the unified map,
 // including prefix-stripping for known prefixable bases.
 func lookupIrregularPast(infinitive string) (ps pastSpec, prefix string, found bool) {
+	e, ok := pastIndex[infinitive]
+	if !ok {
+		return pastSpec{}, "", false
+	}
+	return *e.spec.past, e.prefix, true
+}
+
+// scanIrregularPast is lookupIrregularPast without the index.
+func scanIrregularPast(infinitive string) (ps pastSpec, prefix string, found bool) {
 	// Direct lookup first
 	if s, ok := irregularSpecs[infinitive]; ok && s.past != nil {
 		return *s.past, "", true
 	}
 
-	// Try stripping prefixes to find base irregular verb
-	for _, pfx := range verbPrefixes {
-		if len(infinitive) > len(pfx) && infinitive[:len(pfx)] == pfx {
-			base := infinitive[len(pfx):]
-			if prefixableVerbs[base] {
-				if s, ok := irregularSpecs[base]; ok && s.past != nil {
-					return *s.past, pfx, true
-				}
-			}
+	// Strip a prefix to find the base irregular verb
+	if pfx, base := stripKnownPrefix(infinitive); pfx != "" {
+		if s, ok := irregularSpecs[base]; ok && s.past != nil {
+			return *s.past, pfx, true
 		}
 	}
 
@@ -187,28 +239,34 @@ func lookupIrregularPast(infinitive string) (ps pastSpec, prefix string, found b
 // lookupIrregularVN looks up a verb's verbal noun forms in the unified map,
 // including prefix-stripping for known prefixable bases.
 func lookupIrregularVN(infinitive string) (forms []string, prefix string, found bool) {
+	e, ok := vnIndex[infinitive]
+	if !ok {
+		return nil, "", false
+	}
+	return e.spec.verbalNoun, e.prefix, true
+}
+
+// scanIrregularVN is lookupIrregularVN without the index.
+func scanIrregularVN(infinitive string) (forms []string, prefix string, found bool) {
 	// Direct lookup first
 	if s, ok := irregularSpecs[infinitive]; ok && s.verbalNoun != nil {
 		return s.verbalNoun, "", true
 	}
 
-	// Try stripping prefixes to find base irregular verb
-	for _, pfx := range verbPrefixes {
-		if len(infinitive) > len(pfx) && infinitive[:len(pfx)] == pfx {
-			base := infinitive[len(pfx):]
-			if prefixableVerbs[base] {
-				if s, ok := irregularSpecs[base]; ok && s.verbalNoun != nil {
-					return s.verbalNoun, pfx, true
-				}
-			}
+	// Strip a prefix to find the base irregular verb
+	if pfx, base := stripKnownPrefix(infinitive); pfx != "" {
+		if s, ok := irregularSpecs[base]; ok && s.verbalNoun != nil {
+			return s.verbalNoun, pfx, true
 		}
 	}
 
 	return nil, "", false
 }
 
-// applyPrefixToPresent applies a prefix to all forms of a present tense paradigm.
+// applyPrefixToPresent applies a prefix to all forms of a present tense paradigm,
+// handling epenthetic vowel stripping.
 func applyPrefixToPresent(prefix string, pt PresentTense) PresentTense {
+	prefix = stripEpentheticVowelForPresent(prefix, pt.Sg1)
 	return PresentTense{
 		Sg1: prefix + pt.Sg1,
 		Sg2: prefix + pt.Sg2,
@@ -285,3 +343,56 @@ func stripEpentheticVowelForVN(prefix, baseForm string) string {
 
 	return short
 }
+
+// presentEpentheticPrefixes maps consonant-final prefixes to the form
+// they take before a present stem that begins with a cluster.
+var presentEpentheticPrefixes = map[string]string{
+	"z": "ze", "s": "ze", "w": "we", "od": "ode", "pod": "pode",
+	"nad": "nade", "roz": "roze", "ob": "obe",
+}
+
+// insertEpentheticVowelForPresent is the converse of
+// stripEpentheticVowelForPresent: when the base loses its root vowel in
+// the present, a consonant-final prefix takes e before the new cluster.
+// z+miąć → zemnę, s+piąć → zepnę, roz+ciąć → rozetnę, od+jąć → odejmę.
+// Bases that already start with a cluster keep the bare prefix
+// (s+kląć → sklnę, z+gnić → zgniję).
+func insertEpentheticVowelForPresent(prefix, base, sg1 string) string {
+	long, ok := presentEpentheticPrefixes[prefix]
+	if !ok {
+		return prefix
+	}
+	b1, size := utf8.DecodeRuneInString(base)
+	b2, _ := utf8.DecodeRuneInString(base[size:])
+	s1, size := utf8.DecodeRuneInString(sg1)
+	s2, _ := utf8.DecodeRuneInString(sg1[size:])
+	if isPolishVowel(b1) || !isPolishVowel(b2) || isPolishVowel(s1) || isPolishVowel(s2) {
+		return prefix
+	}
+	return long
+}
+
+// stripEpentheticVowelForPresent strips the trailing 'e' from prefixes like
+// "ode", "roze", "ze" for present tense forms. The infinitive needs the
+// vowel before a consonant cluster (ze+brać → zebrać) that the present
+// stem breaks up, so the vowel goes: zbiorę, odbiorę, rozbiorę, zmielę.
+// It stays when the present still begins with a cluster (zedrę, zejdę,
+// rozerwę) or a vowel, and for the single-consonant z and w before a
+// sibilant or voiceless consonant, where it would assimilate (zesypię,
+// not zsypię).
+func stripEpentheticVowelForPresent(prefix, baseForm string) string {
+	short, ok := epentheticPrefixes[prefix]
+	if !ok || baseForm == "" {
+		return prefix
+	}
+
+	first, size := utf8.DecodeRuneInString(baseForm)
+	second, _ := utf8.DecodeRuneInString(baseForm[size:])
+	if isPolishVowel(first) || !isPolishVowel(second) {
+		return prefix
+	}
+	if len(short) == 1 && strings.ContainsRune("sśzźżptkcćfhw", first) {
+		return prefix
+	}
+	return short
+}
@@ -0,0 +1,271 @@
+package verb
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// TestPrefixableVerbsConsistency checks that prefixableVerbs and the
+// irregular data agree: every listed base has something to prefix, and a
+// prefixed base is handled the same way in every tense the base has
+// irregular data for.
+func TestPrefixableVerbsConsistency(t *testing.T) {
+	for base := range prefixableVerbs {
+		if _, ok := irregularSpecs[base]; !ok {
+			t.Errorf("prefixableVerbs[%q] has no irregular spec; the entry is dead", base)
+		}
+		if expandableHomographs[base] {
+			t.Errorf("%q is in both prefixableVerbs and expandableHomographs", base)
+		}
+	}
+	for base := range expandableHomographs {
+		if _, ok := homographs[base]; !ok {
+			t.Errorf("expandableHomographs[%q] has no homograph entry", base)
+		}
+	}
+
+	// Prefixed forms that deliberately diverge from prefix + base.
+	exceptions := map[string]string{
+		"zatajać":  "utajać/zatajać mean to conceal and take -am",
+		"zawrzeć":  "zawrzeć/dowrzeć have the suppletive past stem war-",
+		"dowrzeć":  "zawrzeć/dowrzeć have the suppletive past stem war-",
+		"przesiąc": "przesiąc is a variant of przesiąknąć",
+	}
+
+	for base, spec := range irregularSpecs {
+		if !prefixableVerbs[base] {
+			continue
+		}
+		for _, prefix := range []string{"za", "prze", "do"} {
+			prefixed := prefix + base
+			if _, ok := exceptions[prefixed]; ok {
+				continue
+			}
+			if spec.present != nil {
+				want, _ := ConjugatePresent(base)
+				got, err := ConjugatePresent(prefixed)
+				if err != nil {
+					t.Errorf("ConjugatePresent(%q) error: %v", prefixed, err)
+				} else if got[0].PresentTense != applyPrefixToPresent(prefix, want[0].PresentTense) {
+					t.Errorf("ConjugatePresent(%q) = %v, want %s + %v", prefixed, got[0].PresentTense, prefix, want[0].PresentTense)
+				}
+			}
+			if spec.past != nil {
+				want, _ := ConjugatePast(base)
+				got, err := ConjugatePast(prefixed)
+				if err != nil {
+					t.Errorf("ConjugatePast(%q) error: %v", prefixed, err)
+				} else if got[0].Sg3M != prefix+want[0].Sg3M {
+					t.Errorf("ConjugatePast(%q) Sg3M = %q, want %q", prefixed, got[0].Sg3M, prefix+want[0].Sg3M)
+				}
+			}
+			if spec.verbalNoun != nil {
+				got, err := VerbalNoun(prefixed)
+				if err != nil {
+					t.Errorf("VerbalNoun(%q) error: %v", prefixed, err)
+				} else if want := applyPrefixToVerbalNoun(prefix, spec.verbalNoun); !slices.Equal(got, want) {
+					t.Errorf("VerbalNoun(%q) = %v, want %v", prefixed, got, want)
+				}
+			}
+		}
+	}
+}
+
+// corpusInfinitives returns every infinitive in the past and verbal noun
+// corpora.
+func corpusInfinitives(t testing.TB) []string {
+	t.Helper()
+	var infinitives []string
+	for _, e := range loadPastCorpus(t) {
+		infinitives = append(infinitives, e.Infinitive)
+	}
+	for _, e := range loadVerbalNounCorpus(t) {
+		infinitives = append(infinitives, e.Infinitive)
+	}
+	return infinitives
+}
+
+// TestIrregularLookupIndex checks that the indexed lookups return what the
+// prefix scans do, for every corpus verb and every indexed form.
+func TestIrregularLookupIndex(t *testing.T) {
+	infinitives := corpusInfinitives(t)
+	for _, index := range []map[string]indexedSpec{presentIndex, pastIndex, vnIndex} {
+		for inf := range index {
+			infinitives = append(infinitives, inf)
+		}
+	}
+
+	for _, inf := range infinitives {
+		check := func(name string, indexed, scanned []any) {
+			if !reflect.DeepEqual(indexed, scanned) {
+				t.Errorf("%s(%q) = %v, scan %v", name, inf, indexed, scanned)
+			}
+		}
+		ps, pfx, ok := lookupIrregularPresent(inf)
+		sps, spfx, sok := scanIrregularPresent(inf)
+		check("lookupIrregularPresent", []any{ps, pfx, ok}, []any{sps, spfx, sok})
+		pp, pfx, ok := lookupIrregularPast(inf)
+		spp, spfx, sok := scanIrregularPast(inf)
+		check("lookupIrregularPast", []any{pp, pfx, ok}, []any{spp, spfx, sok})
+		vn, pfx, ok := lookupIrregularVN(inf)
+		svn, spfx, sok := scanIrregularVN(inf)
+		check("lookupIrregularVN", []any{vn, pfx, ok}, []any{svn, spfx, sok})
+	}
+}
+
+// BenchmarkIrregularLookup runs every corpus infinitive through the three
+// irregular lookups, indexed and scanning the prefixes.
+func BenchmarkIrregularLookup(b *testing.B) {
+	infinitives := corpusInfinitives(b)
+
+	b.Run("indexed", func(b *testing.B) {
+		for b.Loop() {
+			for _, inf := range infinitives {
+				lookupIrregularPresent(inf)
+				lookupIrregularPast(inf)
+				lookupIrregularVN(inf)
+			}
+		}
+	})
+	b.Run("scan", func(b *testing.B) {
+		for b.Loop() {
+			for _, inf := range infinitives {
+				scanIrregularPresent(inf)
+				scanIrregularPast(inf)
+				scanIrregularVN(inf)
+			}
+		}
+	})
+}
+
+func TestStripKnownPrefix(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		prefix     string
+		base       string
+	}{
+		// the longer of two candidate prefixes wins
+		{"rozebrać", "roze", "brać"},
+		{"odebrać", "ode", "brać"},
+		{"podebrać", "pode", "brać"},
+		{"zebrać", "ze", "brać"},
+		{"obejść", "obe", "jść"},
+		{"zejść", "ze", "jść"},
+		{"wejść", "we", "jść"},
+		{"podejść", "pode", "jść"},
+		// a single candidate
+		{"przejechać", "prze", "jechać"},
+		{"przyjechać", "przy", "jechać"},
+		{"wzbić", "wz", "bić"},
+		{"zjeść", "z", "jeść"},
+		{"ukryć", "u", "kryć"},
+		// no prefixable base
+		{"czytać", "", "czytać"},
+		{"brać", "", "brać"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			prefix, base := stripKnownPrefix(tt.infinitive)
+			if prefix != tt.prefix || base != tt.base {
+				t.Errorf("stripKnownPrefix(%q) = %q, %q; want %q, %q",
+					tt.infinitive, prefix, base, tt.prefix, tt.base)
+			}
+		})
+	}
+}
+
+func TestVerbPrefixesLongestFirst(t *testing.T) {
+	for i := 1; i < len(verbPrefixes); i++ {
+		if len([]rune(verbPrefixes[i])) > len([]rune(verbPrefixes[i-1])) {
+			t.Errorf("verbPrefixes: %q comes after the shorter %q", verbPrefixes[i], verbPrefixes[i-1])
+		}
+	}
+}
+
+func TestEpentheticPrefixPresent(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		sg1, pl3   string
+	}{
+		// the vowel goes before a consonant and vowel: ze+biorę
+		{"zebrać", "zbiorę", "zbiorą"},
+		{"odebrać", "odbiorę", "odbiorą"},
+		{"rozebrać", "rozbiorę", "rozbiorą"},
+		{"podebrać", "podbiorę", "podbiorą"},
+		{"nadebrać", "nadbiorę", "nadbiorą"},
+		{"odeprać", "odpiorę", "odpiorą"},
+		// and stays before a cluster
+		{"zejść", "zejdę", "zejdą"},
+		{"odejść", "odejdę", "odejdą"},
+		{"rozerwać", "rozerwę", "rozerwą"},
+		{"zedrzeć", "zedrę", "zedrą"},
+		{"rozesłać", "roześlę", "roześlą"},
+		{"zeżreć", "zeżrę", "zeżrą"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got[0].Sg1 != tt.sg1 || got[0].Pl3 != tt.pl3 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got[0].Sg1, got[0].Pl3, tt.sg1, tt.pl3)
+			}
+		})
+	}
+}
+
+func TestStripEpentheticVowelForPresent(t *testing.T) {
+	tests := []struct {
+		prefix, baseForm, want string
+	}{
+		{"ze", "biorę", "z"},
+		{"roze", "biorę", "roz"},
+		{"ze", "jdę", "ze"},
+		{"ode", "drę", "ode"},
+		// z and w keep the vowel before a voiceless consonant or sibilant
+		{"ze", "piorę", "ze"},
+		{"ze", "sieję", "ze"},
+		{"we", "wiję", "we"},
+		// not an epenthetic prefix
+		{"prze", "biorę", "prze"},
+	}
+
+	for _, tt := range tests {
+		if got := stripEpentheticVowelForPresent(tt.prefix, tt.baseForm); got != tt.want {
+			t.Errorf("stripEpentheticVowelForPresent(%q, %q) = %q, want %q", tt.prefix, tt.baseForm, got, tt.want)
+		}
+	}
+}
+
+// TestPresentSpecClassIVPl3 checks that a class IV spec with a separate
+// sg1/pl3 stem builds pl3 from that stem plus -ą (jedzą, not jedzją),
+// and one without it keeps -ją (umieją).
+func TestPresentSpecClassIVPl3(t *testing.T) {
+	tests := []struct {
+		spec presentSpec
+		want string
+	}{
+		{presentSpec{stem: "je", sg13: "jedz", class: ConjIV}, "jedzą"},
+		{presentSpec{stem: "wie", sg13: "wiedz", class: ConjIV}, "wiedzą"},
+		{presentSpec{stem: "umie", class: ConjIV}, "umieją"},
+	}
+	for _, tt := range tests {
+		if got := tt.spec.build().Pl3; got != tt.want {
+			t.Errorf("%+v.build().Pl3 = %q, want %q", tt.spec, got, tt.want)
+		}
+	}
+
+	for infinitive, want := range map[string]string{
+		"jeść": "jedzą", "zjeść": "zjedzą", "wiedzieć": "wiedzą", "umieć": "umieją",
+	} {
+		got, err := ConjugatePresent(infinitive)
+		if err != nil || got[0].Pl3 != want {
+			t.Errorf("ConjugatePresent(%q) pl3 = %v, %v; want %s", infinitive, got, err, want)
+		}
+	}
+}
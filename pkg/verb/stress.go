@@ -0,0 +1,82 @@
+package verb
+
+import (
+	"strings"
+	"unicode"
+)
+
+// stressMark is the combining acute accent AccentForm places after the
+// stressed vowel: czyta → czýta.
+const stressMark = "\u0301"
+
+// stressClitics are the endings the stress ignores, longest first, with
+// their syllable counts: the past person endings -śmy/-ście and the
+// conditional -by-. After them stress stays on the vowel before the -ł-/-l-
+// of the l-participle: czytáliśmy, czytáłby, czytáłabym, czytálibyśmy.
+var stressClitics = []struct {
+	ending    string
+	syllables int
+}{
+	{"byśmy", 2}, {"byście", 2},
+	{"bym", 1}, {"byś", 1}, {"by", 1},
+	{"śmy", 1}, {"ście", 1},
+}
+
+// AccentForm marks the stressed vowel of each word in a form with a
+// combining acute accent: czytam → czýtam, czytaliśmy → czytáliśmy, będę
+// czytać → bę́dę czýtać. Stress falls on the penultimate syllable except in
+// past 1pl/2pl and conditional forms (see stressClitics). Monosyllables
+// have no choice of syllable and are left unmarked, as is się.
+func AccentForm(form string) string {
+	words := strings.Split(form, " ")
+	for i, w := range words {
+		words[i] = accentWord(w)
+	}
+	return strings.Join(words, " ")
+}
+
+// accentWord marks the stressed vowel of a single word.
+func accentWord(word string) string {
+	syllables := Syllabify(word)
+	if len(syllables) < 2 {
+		return word
+	}
+	stressed := len(syllables) - min(stressFromEnd(word), len(syllables))
+	runes := []rune(syllables[stressed])
+	nucleus := 0
+	for i, r := range runes {
+		if isPolishVowel(unicode.ToLower(r)) {
+			nucleus = i // a softening i comes before the nucleus: dzia
+		}
+	}
+	syllables[stressed] = string(runes[:nucleus+1]) + stressMark + string(runes[nucleus+1:])
+	return strings.Join(syllables, "")
+}
+
+// stressFromEnd returns the stressed syllable of a word counted from the
+// end: 2 for the penult, more when clitics follow an l-participle.
+func stressFromEnd(word string) int {
+	lower := strings.ToLower(word)
+	for _, c := range stressClitics {
+		rest, ok := strings.CutSuffix(lower, c.ending)
+		if !ok || !isLParticiple(rest) {
+			continue
+		}
+		if strings.HasSuffix(rest, "ł") {
+			return c.syllables + 1 // czytał-by
+		}
+		return c.syllables + 2 // czyta-ła-bym
+	}
+	return 2
+}
+
+// isLParticiple reports whether a word ends in an l-participle ending, the
+// stem the past and conditional clitics attach to: czytał, czytali.
+func isLParticiple(s string) bool {
+	for _, ending := range []string{"ł", "ła", "ło", "li", "ły"} {
+		if strings.HasSuffix(s, ending) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package verb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAccentForm(t *testing.T) {
+	tests := []struct {
+		form string
+		want string // ' follows the stressed vowel
+	}{
+		// penultimate by default
+		{"czytam", "czy'tam"},
+		{"czytamy", "czyta'my"},
+		{"przeczytałem", "przeczyta'łem"},
+		{"siedziałem", "siedzia'łem"},
+		{"będę czytać", "bę'dę czy'tać"},
+		// past 1pl/2pl: antepenultimate
+		{"czytaliśmy", "czyta'liśmy"},
+		{"czytałyście", "czyta'łyście"},
+		// conditional
+		{"czytałby", "czyta'łby"},
+		{"czytałabym", "czyta'łabym"},
+		{"czytalibyśmy", "czyta'libyśmy"},
+		{"byłbym", "by'łbym"},
+		{"zrobiłbyś", "zrobi'łbyś"},
+		// not a clitic after a present stem
+		{"jesteśmy", "jeste'śmy"},
+		// monosyllables and się are left alone
+		{"ma", "ma"},
+		{"boję się", "bo'ję się"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.form, func(t *testing.T) {
+			want := strings.ReplaceAll(tt.want, "'", stressMark)
+			if got := AccentForm(tt.form); got != want {
+				t.Errorf("AccentForm(%q) = %q, want %q", tt.form, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package verb
+
+import "strings"
+
+// consonantDigraphs are the consonant letters written with two characters.
+// szcz is sz + cz.
+var consonantDigraphs = []string{"ch", "cz", "dz", "dź", "dż", "rz", "sz"}
+
+// sonorants are the consonants that close a syllable rather than open a
+// cluster inside a word: kar-tka, zam-knąć, przyj-mo-wać.
+var sonorants = map[string]bool{
+	"r": true, "l": true, "ł": true, "m": true, "n": true, "ń": true, "j": true,
+}
+
+// Syllabify splits a Polish word into syllables: przeczytać → prze, czy,
+// tać. Each vowel (a e i o u y ą ę ó) is the nucleus of one syllable,
+// except an i after a consonant and before another vowel, which only
+// softens the consonant: sie-dzia-łem, dzie-cko.
+//
+// Consonants between two vowels go to the following syllable as far as
+// they can open it (onset maximization), with digraphs kept whole: mo-rze,
+// de-szczu, o-trzy-mać. A sonorant (r l ł m n ń j) followed by another
+// consonant closes the preceding syllable instead: kar-tka, zam-knąć.
+// Clusters at the edges of the word stay with the first and last
+// syllables, so trzmiel and źdźbło are one syllable each.
+func Syllabify(word string) []string {
+	units := letterUnits(word)
+
+	var nuclei []int
+	for i, u := range units {
+		if u.vowel {
+			nuclei = append(nuclei, i)
+		}
+	}
+	if len(nuclei) < 2 {
+		return []string{word}
+	}
+
+	syllables := make([]string, 0, len(nuclei))
+	start := 0
+	for k := 0; k < len(nuclei)-1; k++ {
+		split := onsetStart(units, nuclei[k]+1, nuclei[k+1])
+		syllables = append(syllables, joinUnits(units[start:split]))
+		start = split
+	}
+	return append(syllables, joinUnits(units[start:]))
+}
+
+// letterUnit is a vowel or a consonant letter, digraphs and softening i
+// included: sz, dzi, a.
+type letterUnit struct {
+	text  string
+	lower string
+	vowel bool
+}
+
+// letterUnits splits a word into vowels and consonant letters. An i after
+// a consonant and before a vowel joins the consonant: dzia → dzi, a.
+func letterUnits(word string) []letterUnit {
+	runes := []rune(word)
+	lower := []rune(strings.ToLower(word))
+
+	var units []letterUnit
+	for i := 0; i < len(runes); {
+		n := 1
+		for _, d := range consonantDigraphs {
+			if strings.HasPrefix(string(lower[i:]), d) {
+				n = len([]rune(d))
+				break
+			}
+		}
+		vowel := n == 1 && isPolishVowel(lower[i])
+		if !vowel && i+n+1 < len(runes) && lower[i+n] == 'i' && isPolishVowel(lower[i+n+1]) {
+			n++ // softening i: si-e, dzi-a
+		}
+		units = append(units, letterUnit{
+			text:  string(runes[i : i+n]),
+			lower: string(lower[i : i+n]),
+			vowel: vowel,
+		})
+		i += n
+	}
+	return units
+}
+
+// onsetStart returns the index of the first consonant unit in
+// units[from:to] that belongs to the syllable starting at to: the longest
+// run of consonants with no sonorant before another consonant.
+func onsetStart(units []letterUnit, from, to int) int {
+	start := to
+	for start > from {
+		if sonorants[units[start-1].lower] && start != to {
+			break
+		}
+		start--
+	}
+	return start
+}
+
+// joinUnits concatenates the original text of units.
+func joinUnits(units []letterUnit) string {
+	var b strings.Builder
+	for _, u := range units {
+		b.WriteString(u.text)
+	}
+	return b.String()
+}
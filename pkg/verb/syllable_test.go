@@ -0,0 +1,51 @@
+package verb
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSyllabify(t *testing.T) {
+	tests := []struct {
+		word string
+		want string // syllables joined with -
+	}{
+		// digraphs stay whole
+		{"przeczytać", "prze-czy-tać"},
+		{"chichotać", "chi-cho-tać"},
+		{"morze", "mo-rze"},
+		{"deszczu", "de-szczu"},
+		{"otrzymać", "o-trzy-mać"},
+		// softening i
+		{"siedziałem", "sie-dzia-łem"},
+		{"dziecko", "dzie-cko"},
+		{"ziemniak", "ziem-niak"},
+		// sonorants close the syllable before a consonant
+		{"kartka", "kar-tka"},
+		{"zamknąć", "zam-knąć"},
+		{"przyjmować", "przyj-mo-wać"},
+		{"bełkotać", "beł-ko-tać"},
+		{"kołdra", "koł-dra"},
+		// vowels in hiatus
+		{"nauka", "na-u-ka"},
+		// edge clusters and monosyllables
+		{"trzmiel", "trzmiel"},
+		{"źdźbło", "źdźbło"},
+		{"pstrąg", "pstrąg"},
+		{"wziąć", "wziąć"},
+		{"iść", "iść"},
+		{"przyszedłszy", "przy-szedł-szy"},
+		// case is kept
+		{"Przeczytać", "Prze-czy-tać"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			want := strings.Split(tt.want, "-")
+			if got := Syllabify(tt.word); !slices.Equal(got, want) {
+				t.Errorf("Syllabify(%q) = %q, want %q", tt.word, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package verb
+
+// Table is a paradigm laid out as labeled rows, one per person/number
+// (and, in the past, gender) slot. Every tense converts to the same shape,
+// so printers and exporters need only handle Table.
+type Table struct {
+	Tense Tense
+	Rows  []TableRow
+}
+
+// TableRow is one slot of a paradigm. Gender is zero for tenses that do not
+// inflect for it. Forms holds the slot's forms, most often just one.
+type TableRow struct {
+	Person Person
+	Number Number
+	Gender Gender
+	Label  string
+	Forms  []string
+}
+
+// Slot identifies one cell of a paradigm, and one row of its Table.
+// Gender is zero for present tense slots.
+type Slot struct {
+	Person Person
+	Number Number
+	Gender Gender
+}
+
+// presentSlots lists the present tense slots in PresentTense field order.
+var presentSlots = [6]Slot{
+	{First, Singular, 0}, {Second, Singular, 0}, {Third, Singular, 0},
+	{First, Plural, 0}, {Second, Plural, 0}, {Third, Plural, 0},
+}
+
+// pastSlots lists the past tense slots in PastTense field order.
+var pastSlots = [13]Slot{
+	{First, Singular, Masculine}, {First, Singular, Feminine},
+	{Second, Singular, Masculine}, {Second, Singular, Feminine},
+	{Third, Singular, Masculine}, {Third, Singular, Feminine}, {Third, Singular, Neuter},
+	{First, Plural, MascPersonal}, {First, Plural, NonMascPersonal},
+	{Second, Plural, MascPersonal}, {Second, Plural, NonMascPersonal},
+	{Third, Plural, MascPersonal}, {Third, Plural, NonMascPersonal},
+}
+
+// imperativeSlots lists the imperative slots in ImperativeParadigm field
+// order.
+var imperativeSlots = [3]Slot{
+	{Second, Singular, 0}, {First, Plural, 0}, {Second, Plural, 0},
+}
+
+// Table returns the present tense paradigm as a six-row table.
+func (p PresentTense) Table(labels Labels) Table {
+	l := presentLabels[labels]
+	rows := make([]TableRow, len(presentSlots))
+	for i, s := range presentSlots {
+		rows[i] = TableRow{
+			Person: s.Person,
+			Number: s.Number,
+			Label:  l[i],
+			Forms:  []string{p.Get(s.Person, s.Number)},
+		}
+	}
+	return Table{Tense: Present, Rows: rows}
+}
+
+// Table returns the past tense paradigm as a 13-row table.
+func (p PastTense) Table(labels Labels) Table {
+	l := pastLabels[labels]
+	rows := make([]TableRow, len(pastSlots))
+	for i, s := range pastSlots {
+		rows[i] = TableRow{
+			Person: s.Person,
+			Number: s.Number,
+			Gender: s.Gender,
+			Label:  l[i],
+			Forms:  []string{p.Get(s.Person, s.Number, s.Gender)},
+		}
+	}
+	return Table{Tense: Past, Rows: rows}
+}
+
+// Table returns the future paradigm as a six-row table. A synthetic future
+// has one form per row; an analytic one lists the infinitive construction
+// first, then the l-participle forms of each gender: będę czytać, będę
+// czytał, będę czytała. Slots emptied by WithStyle are left out.
+func (p FutureParadigm) Table(labels Labels) Table {
+	l := presentLabels[labels]
+	rows := make([]TableRow, len(presentSlots))
+	for i, s := range presentSlots {
+		var forms []string
+		if form := p.Get(s.Person, s.Number); form != "" {
+			forms = append(forms, form)
+		}
+		if p.Participle != nil {
+			for _, ps := range pastSlots {
+				if ps.Person == s.Person && ps.Number == s.Number {
+					forms = append(forms, p.Participle.Get(ps.Person, ps.Number, ps.Gender))
+				}
+			}
+		}
+		rows[i] = TableRow{Person: s.Person, Number: s.Number, Label: l[i], Forms: forms}
+	}
+	return Table{Tense: Future, Rows: rows}
+}
+
+// Table returns the conditional paradigm as a 13-row table, laid out as
+// the past tense it is built on.
+func (p ConditionalParadigm) Table(labels Labels) Table {
+	t := p.PastTense.Table(labels)
+	t.Tense = ConditionalMood
+	return t
+}
+
+// Table returns the imperative paradigm as a three-row table: 2sg, 1pl
+// and 2pl.
+func (p ImperativeParadigm) Table(labels Labels) Table {
+	l := presentLabels[labels]
+	values := [3]string{p.Sg2, p.Pl1, p.Pl2}
+	rows := make([]TableRow, len(imperativeSlots))
+	for i, s := range imperativeSlots {
+		rows[i] = TableRow{
+			Person: s.Person,
+			Number: s.Number,
+			Label:  l[presentSlotIndex(s)],
+			Forms:  []string{values[i]},
+		}
+	}
+	return Table{Tense: ImperativeMood, Rows: rows}
+}
+
+// presentSlotIndex returns the position of a person/number slot in
+// presentSlots, and so in the present label sets.
+func presentSlotIndex(s Slot) int {
+	return (int(s.Number)-1)*3 + int(s.Person) - 1
+}
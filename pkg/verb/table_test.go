@@ -0,0 +1,125 @@
+package verb
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPresentTenseTable(t *testing.T) {
+	paradigms, err := ConjugatePresent("czytać")
+	if err != nil {
+		t.Fatalf("ConjugatePresent error: %v", err)
+	}
+	p := paradigms[0].PresentTense
+
+	table := p.Table(AbbrevLabels)
+	if table.Tense != Present {
+		t.Errorf("Tense = %v, want present", table.Tense)
+	}
+	if len(table.Rows) != 6 {
+		t.Fatalf("got %d rows, want 6", len(table.Rows))
+	}
+	forms := p.Forms(AbbrevLabels)
+	for i, row := range table.Rows {
+		if row.Gender != 0 {
+			t.Errorf("row %s has gender %v, want none", row.Label, row.Gender)
+		}
+		if want := p.Get(row.Person, row.Number); !slices.Equal(row.Forms, []string{want}) {
+			t.Errorf("row %s forms = %v, want [%s]", row.Label, row.Forms, want)
+		}
+		if row.Label != forms[i].Label {
+			t.Errorf("row %d label = %q, want %q", i, row.Label, forms[i].Label)
+		}
+	}
+}
+
+func TestPastTenseTable(t *testing.T) {
+	paradigms, err := ConjugatePast("czytać")
+	if err != nil {
+		t.Fatalf("ConjugatePast error: %v", err)
+	}
+	p := paradigms[0].PastTense
+
+	table := p.Table(PolishLabels)
+	if table.Tense != Past {
+		t.Errorf("Tense = %v, want past", table.Tense)
+	}
+	if len(table.Rows) != 13 {
+		t.Fatalf("got %d rows, want 13", len(table.Rows))
+	}
+	forms := p.Forms(PolishLabels)
+	for i, row := range table.Rows {
+		if want := p.Get(row.Person, row.Number, row.Gender); !slices.Equal(row.Forms, []string{want}) {
+			t.Errorf("row %s forms = %v, want [%s]", row.Label, row.Forms, want)
+		}
+		if row.Forms[0] != forms[i].Value || row.Label != forms[i].Label {
+			t.Errorf("row %d = %s %v, want %s %s", i, row.Label, row.Forms, forms[i].Label, forms[i].Value)
+		}
+	}
+}
+
+func TestFutureTable(t *testing.T) {
+	analytic, err := ConjugateFuture("czytać")
+	if err != nil {
+		t.Fatalf("ConjugateFuture(czytać) error: %v", err)
+	}
+	table := analytic[0].Table(AbbrevLabels)
+	if table.Tense != Future || len(table.Rows) != 6 {
+		t.Fatalf("Table() = %v with %d rows, want future with 6", table.Tense, len(table.Rows))
+	}
+	if want := []string{"będę czytać", "będę czytał", "będę czytała"}; !slices.Equal(table.Rows[0].Forms, want) {
+		t.Errorf("1sg forms = %v, want %v", table.Rows[0].Forms, want)
+	}
+	if want := []string{"będzie czytać", "będzie czytał", "będzie czytała", "będzie czytało"}; !slices.Equal(table.Rows[2].Forms, want) {
+		t.Errorf("3sg forms = %v, want %v", table.Rows[2].Forms, want)
+	}
+	participle := analytic[0].WithStyle(FutureParticiple).Table(AbbrevLabels)
+	if want := []string{"będą czytali", "będą czytały"}; !slices.Equal(participle.Rows[5].Forms, want) {
+		t.Errorf("3pl forms with FutureParticiple = %v, want %v", participle.Rows[5].Forms, want)
+	}
+
+	synthetic, err := ConjugateFuture("napisać")
+	if err != nil {
+		t.Fatalf("ConjugateFuture(napisać) error: %v", err)
+	}
+	for i, row := range synthetic[0].Table(PolishLabels).Rows {
+		if want := synthetic[0].PresentTense.Forms(PolishLabels)[i]; !slices.Equal(row.Forms, []string{want.Value}) || row.Label != want.Label {
+			t.Errorf("row %d = %s %v, want %s %s", i, row.Label, row.Forms, want.Label, want.Value)
+		}
+	}
+}
+
+func TestConditionalTable(t *testing.T) {
+	paradigms, err := ConjugateConditional("czytać")
+	if err != nil {
+		t.Fatalf("ConjugateConditional(czytać) error: %v", err)
+	}
+	table := paradigms[0].Table(AbbrevLabels)
+	if table.Tense != ConditionalMood || len(table.Rows) != 13 {
+		t.Fatalf("Table() = %v with %d rows, want conditional with 13", table.Tense, len(table.Rows))
+	}
+	if row := table.Rows[0]; row.Label != "1sg.m" || !slices.Equal(row.Forms, []string{"czytałbym"}) {
+		t.Errorf("row 0 = %s %v, want 1sg.m czytałbym", row.Label, row.Forms)
+	}
+}
+
+func TestImperativeTable(t *testing.T) {
+	paradigms, err := Imperative("pisać")
+	if err != nil {
+		t.Fatalf("Imperative(pisać) error: %v", err)
+	}
+	table := paradigms[0].Table(PolishLabels)
+	if table.Tense != ImperativeMood {
+		t.Errorf("Tense = %v, want imperative", table.Tense)
+	}
+	want := []TableRow{
+		{Second, Singular, 0, "ty", []string{"pisz"}},
+		{First, Plural, 0, "my", []string{"piszmy"}},
+		{Second, Plural, 0, "wy", []string{"piszcie"}},
+	}
+	if !slices.EqualFunc(table.Rows, want, func(a, b TableRow) bool {
+		return a.Person == b.Person && a.Number == b.Number && a.Label == b.Label && slices.Equal(a.Forms, b.Forms)
+	}) {
+		t.Errorf("Rows = %+v, want %+v", table.Rows, want)
+	}
+}
@@ -0,0 +1,44 @@
+package verb
+
+import "fmt"
+
+// ThirdPerson returns the third person singular and plural forms of a verb
+// in the given tense: czyta, czytają or czytał, czytali. These are the forms
+// most often wanted for examples and dictionary entries.
+//
+// In the past tense the singular is masculine and the plural virile unless
+// genders says otherwise: a singular gender (Masculine, Feminine, Neuter)
+// selects the singular form, a plural one (MascPersonal, NonMascPersonal)
+// the plural form. Genders are ignored in the present tense.
+//
+// Homographs use their primary (first) paradigm. Only Present and Past are
+// supported; other tenses return an error.
+func ThirdPerson(infinitive string, tense Tense, genders ...Gender) (sg, pl string, err error) {
+	switch tense {
+	case Present:
+		paradigms, err := ConjugatePresent(infinitive)
+		if err != nil {
+			return "", "", err
+		}
+		p := paradigms[0]
+		return p.Sg3, p.Pl3, nil
+	case Past:
+		paradigms, err := ConjugatePast(infinitive)
+		if err != nil {
+			return "", "", err
+		}
+		sgGender, plGender := Masculine, MascPersonal
+		for _, g := range genders {
+			switch g {
+			case Masculine, Feminine, Neuter:
+				sgGender = g
+			case MascPersonal, NonMascPersonal:
+				plGender = g
+			}
+		}
+		p := paradigms[0]
+		return p.Get(Third, Singular, sgGender), p.Get(Third, Plural, plGender), nil
+	default:
+		return "", "", fmt.Errorf("unsupported tense: %v", tense)
+	}
+}
@@ -0,0 +1,46 @@
+package verb
+
+import "testing"
+
+func TestThirdPerson(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		tense      Tense
+		genders    []Gender
+		wantSg     string
+		wantPl     string
+	}{
+		{"czytać", Present, nil, "czyta", "czytają"},
+		{"pisać", Present, nil, "pisze", "piszą"},
+		{"bać się", Present, nil, "boi się", "boją się"},
+		{"stać", Present, nil, "stoi", "stoją"}, // homograph: primary paradigm
+		{"czytać", Past, nil, "czytał", "czytali"},
+		{"czytać", Past, []Gender{Feminine}, "czytała", "czytali"},
+		{"czytać", Past, []Gender{Neuter, NonMascPersonal}, "czytało", "czytały"},
+		{"iść", Past, nil, "szedł", "szli"},
+		{"iść", Past, []Gender{Feminine, NonMascPersonal}, "szła", "szły"},
+		{"paść", Past, nil, "padł", "padli"}, // homograph: most frequent paradigm
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tense.String()+"/"+tt.infinitive, func(t *testing.T) {
+			sg, pl, err := ThirdPerson(tt.infinitive, tt.tense, tt.genders...)
+			if err != nil {
+				t.Fatalf("ThirdPerson(%q, %v) error: %v", tt.infinitive, tt.tense, err)
+			}
+			if sg != tt.wantSg || pl != tt.wantPl {
+				t.Errorf("ThirdPerson(%q, %v, %v) = %s, %s; want %s, %s",
+					tt.infinitive, tt.tense, tt.genders, sg, pl, tt.wantSg, tt.wantPl)
+			}
+		})
+	}
+}
+
+func TestThirdPersonErrors(t *testing.T) {
+	if _, _, err := ThirdPerson("czytać", Tense(0)); err == nil {
+		t.Error("ThirdPerson with unknown tense: expected error")
+	}
+	if _, _, err := ThirdPerson("xyz", Present); err == nil {
+		t.Error(`ThirdPerson("xyz", Present): expected error`)
+	}
+}
@@ -0,0 +1,18 @@
+package verb
+
+import "strings"
+
+// asciiReplacer strips the diacritics of the Polish alphabet.
+var asciiReplacer = strings.NewReplacer(
+	"ą", "a", "ć", "c", "ę", "e", "ł", "l", "ń", "n", "ó", "o", "ś", "s", "ź", "z", "ż", "z",
+	"Ą", "A", "Ć", "C", "Ę", "E", "Ł", "L", "Ń", "N", "Ó", "O", "Ś", "S", "Ź", "Z", "Ż", "Z",
+)
+
+// ASCIIize replaces each Polish letter with a diacritic by its base
+// letter, for URL slugs and terminals without Polish fonts: żółć → zolc,
+// będę → bede. The mapping is lossy by design: ź and ż both become z, so
+// the result cannot be turned back into Polish. Other characters are left
+// as they are.
+func ASCIIize(form string) string {
+	return asciiReplacer.Replace(form)
+}
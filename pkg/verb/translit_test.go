@@ -0,0 +1,26 @@
+package verb
+
+import "testing"
+
+func TestASCIIize(t *testing.T) {
+	tests := []struct {
+		form, want string
+	}{
+		// every diacritic, lower and upper case
+		{"ąćęłńóśźż", "acelnoszz"},
+		{"ĄĆĘŁŃÓŚŹŻ", "ACELNOSZZ"},
+		{"zażółć gęślą jaźń", "zazolc gesla jazn"},
+		{"będę czytać", "bede czytac"},
+		// ź and ż collapse
+		{"gryźć", "gryzc"},
+		{"żyć", "zyc"},
+		{"czytam", "czytam"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := ASCIIize(tt.form); got != tt.want {
+			t.Errorf("ASCIIize(%q) = %q, want %q", tt.form, got, tt.want)
+		}
+	}
+}
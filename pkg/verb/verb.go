@@ -1,8 +1,9 @@
 package verb
 
 import (
-	"fmt"
+	"slices"
 	"strings"
+	"unicode/utf8"
 )
 
 type Person int
@@ -20,14 +21,46 @@ const (
 	Plural
 )
 
+// Tense selects a conjugation: the present, past or future tense, or the
+// conditional or imperative mood.
+type Tense int
+
+const (
+	Present Tense = iota + 1
+	Past
+	Future
+	// The moods are suffixed, as Imperative already names a function.
+	ConditionalMood
+	ImperativeMood
+)
+
+// String returns the tense name: present, past, future, conditional or
+// imperative.
+func (t Tense) String() string {
+	switch t {
+	case Present:
+		return "present"
+	case Past:
+		return "past"
+	case Future:
+		return "future"
+	case ConditionalMood:
+		return "conditional"
+	case ImperativeMood:
+		return "imperative"
+	default:
+		return "unknown"
+	}
+}
+
 // PresentTense holds all six forms of the present tense paradigm.
 type PresentTense struct {
-	Sg1 string // ja
-	Sg2 string // ty
-	Sg3 string // on/ona/ono
-	Pl1 string // my
-	Pl2 string // wy
-	Pl3 string // oni/one
+	Sg1 string `json:"sg1"` // ja
+	Sg2 string `json:"sg2"` // ty
+	Sg3 string `json:"sg3"` // on/ona/ono
+	Pl1 string `json:"pl1"` // my
+	Pl2 string `json:"pl2"` // wy
+	Pl3 string `json:"pl3"` // oni/one
 }
 
 // Get returns the form for the given person and number.
@@ -76,24 +109,24 @@ const (
 // masculine-personal (virile) / non-masculine-personal in plural.
 type PastTense struct {
 	// Singular - ja (1st person)
-	Sg1M string // ja (masculine) - czytałem
-	Sg1F string // ja (feminine) - czytałam
+	Sg1M string `json:"sg1m"` // ja (masculine) - czytałem
+	Sg1F string `json:"sg1f"` // ja (feminine) - czytałam
 	// Singular - ty (2nd person)
-	Sg2M string // ty (masculine) - czytałeś
-	Sg2F string // ty (feminine) - czytałaś
+	Sg2M string `json:"sg2m"` // ty (masculine) - czytałeś
+	Sg2F string `json:"sg2f"` // ty (feminine) - czytałaś
 	// Singular - on/ona/ono (3rd person)
-	Sg3M string // on (masculine) - czytał
-	Sg3F string // ona (feminine) - czytała
-	Sg3N string // ono (neuter) - czytało
+	Sg3M string `json:"sg3m"` // on (masculine) - czytał
+	Sg3F string `json:"sg3f"` // ona (feminine) - czytała
+	Sg3N string `json:"sg3n"` // ono (neuter) - czytało
 	// Plural - my (1st person)
-	Pl1V  string // my (masculine-personal/virile) - czytaliśmy
-	Pl1NV string // my (non-masculine-personal) - czytałyśmy
+	Pl1V  string `json:"pl1v"`  // my (masculine-personal/virile) - czytaliśmy
+	Pl1NV string `json:"pl1nv"` // my (non-masculine-personal) - czytałyśmy
 	// Plural - wy (2nd person)
-	Pl2V  string // wy (masculine-personal) - czytaliście
-	Pl2NV string // wy (non-masculine-personal) - czytałyście
+	Pl2V  string `json:"pl2v"`  // wy (masculine-personal) - czytaliście
+	Pl2NV string `json:"pl2nv"` // wy (non-masculine-personal) - czytałyście
 	// Plural - oni/one (3rd person)
-	Pl3V  string // oni (masculine-personal) - czytali
-	Pl3NV string // one (non-masculine-personal) - czytały
+	Pl3V  string `json:"pl3v"`  // oni (masculine-personal) - czytali
+	Pl3NV string `json:"pl3nv"` // one (non-masculine-personal) - czytały
 }
 
 // Get returns the form for the given person, number, and gender.
@@ -143,120 +176,305 @@ func (p PastTense) Equals(other PastTense) bool {
 // PastParadigm represents a past tense conjugation paradigm with optional gloss.
 type PastParadigm struct {
 	PastTense
-	Gloss string
+	Gloss      string     `json:"gloss,omitempty"`
+	Confidence Confidence `json:"confidence"`
+	Defective  []Slot     `json:"defective,omitempty"` // slots with no form in use, as in Paradigm
 }
 
 // Paradigm represents a conjugation paradigm with an optional gloss.
 // Homographs (verbs with multiple meanings) have multiple paradigms.
 type Paradigm struct {
 	PresentTense
-	Gloss string // e.g., "to stand", "to become" (empty for non-homographs)
+	Gloss      string     `json:"gloss,omitempty"` // e.g., "to stand", "to become" (empty for non-homographs)
+	Confidence Confidence `json:"confidence"`
+	// Defective lists the slots of a defective verb that have no form in
+	// use: every slot but sg3 for dnieć. The forms are still filled in.
+	Defective []Slot `json:"defective,omitempty"`
+	// Guessed marks a paradigm ConjugatePresentGuess made up by analogy
+	// for a verb no rule matched.
+	Guessed bool `json:"guessed,omitempty"`
 }
 
 // ConjugatePresent returns all valid present tense paradigms for a verb.
-// Most verbs return a single paradigm; homographs return multiple.
+// Most verbs return a single paradigm; homographs return multiple, most
+// frequent first where the embedded frequency data ranks them.
+//
+// The infinitive is normalized first, here and in the other entry points:
+// surrounding spaces are dropped, decomposed diacritics composed and
+// letters lowercased, so " Czytać", "CZYTAĆ" and "czytać" all match. The
+// forms come back capitalized when the input was (Czytam for Czytać);
+// functions other than ConjugatePresent, ConjugatePast, VerbalNoun and
+// those built directly on them return lowercase forms.
 func ConjugatePresent(infinitive string) ([]Paradigm, error) {
+	infinitive, capital := normalizeInfinitive(infinitive)
+	explained, err := conjugatePresentExplained(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	paradigms := make([]Paradigm, 0, len(explained))
+	for _, e := range explained {
+		paradigms = appendParadigm(paradigms, e.Paradigm)
+	}
+	sortParadigmsByFrequency(paradigms, func(p Paradigm) int {
+		return paradigmRank(Present, infinitive, p.Sg1)
+	})
+	if slots := defectiveSlots(infinitive, Present); slots != nil {
+		for i := range paradigms {
+			paradigms[i].Defective = slots
+		}
+	}
+	if capital {
+		for i := range paradigms {
+			paradigms[i].PresentTense = paradigms[i].capitalized()
+		}
+	}
+	return paradigms, nil
+}
+
+// appendParadigm appends p unless an equal paradigm is already listed, in
+// which case p's gloss is merged into that one's. Homograph and dual-form
+// paths can produce the same forms twice once a prefix is applied.
+func appendParadigm(paradigms []Paradigm, p Paradigm) []Paradigm {
+	for i := range paradigms {
+		if paradigms[i].PresentTense.Equals(p.PresentTense) {
+			paradigms[i].Gloss = mergeGloss(paradigms[i].Gloss, p.Gloss)
+			return paradigms
+		}
+	}
+	return append(paradigms, p)
+}
+
+// appendPastParadigm is appendParadigm for the past tense.
+func appendPastParadigm(paradigms []PastParadigm, p PastParadigm) []PastParadigm {
+	for i := range paradigms {
+		if paradigms[i].PastTense.Equals(p.PastTense) {
+			paradigms[i].Gloss = mergeGloss(paradigms[i].Gloss, p.Gloss)
+			return paradigms
+		}
+	}
+	return append(paradigms, p)
+}
+
+// mergeGloss combines the glosses of two paradigms with the same forms.
+// A gloss that adds nothing (empty, the same, or the first marked as a
+// variant) is dropped; distinct meanings are joined: "to fall; to graze".
+func mergeGloss(first, second string) string {
+	switch second {
+	case "", first, first + " (variant)":
+		return first
+	}
+	if first == "" {
+		return second
+	}
+	return first + "; " + second
+}
+
+// ExplainedParadigm is a present tense paradigm with the rule that
+// produced it.
+type ExplainedParadigm struct {
+	Paradigm
+	// Source names the rule: "homograph", "irregular lookup", the
+	// heuristic function (e.g. "heuristicIc"), or for prefixed irregulars
+	// the prefix and base verb ("prefix:przy+base:pisać"), or for archaic
+	// -ąc infinitives the modern verb ("archaic:przysięgnąć"). Reflexive
+	// verbs report the source of their base.
+	Source string
+}
+
+// ConjugatePresentExplained is ConjugatePresent with each paradigm
+// attributed to the rule that produced it.
+func ConjugatePresentExplained(infinitive string) ([]ExplainedParadigm, error) {
+	infinitive, capital := normalizeInfinitive(infinitive)
+	explained, err := conjugatePresentExplained(infinitive)
+	if capital {
+		for i := range explained {
+			explained[i].PresentTense = explained[i].capitalized()
+		}
+	}
+	return explained, err
+}
+
+// conjugatePresentExplained is ConjugatePresentExplained for a
+// normalized infinitive.
+func conjugatePresentExplained(infinitive string) ([]ExplainedParadigm, error) {
+	if err := checkInfinitive(infinitive); err != nil {
+		return nil, err
+	}
+
+	// Reflexive verbs conjugate like their base: bać się → boję się
+	if base, ok := splitReflexive(infinitive); ok {
+		explained, err := conjugatePresentExplained(base)
+		if err != nil {
+			return nil, err
+		}
+		for i := range explained {
+			explained[i].PresentTense = explained[i].withReflexive()
+		}
+		return explained, nil
+	}
+
 	// Check homographs first (verbs with multiple valid paradigms)
-	if paradigms, ok := lookupHomograph(infinitive); ok {
-		return paradigms, nil
+	if paradigms, prefix, ok := lookupHomograph(infinitive); ok {
+		source := "homograph"
+		if prefix != "" {
+			source = prefixSource(prefix, strings.TrimPrefix(infinitive, prefix)) + " (homograph)"
+		}
+		explained := make([]ExplainedParadigm, len(paradigms))
+		for i, p := range paradigms {
+			explained[i] = ExplainedParadigm{Paradigm: p, Source: source}
+		}
+		return explained, nil
 	}
 
 	// Check irregular verbs (including prefixed forms)
 	if ps, prefix, ok := lookupIrregularPresent(infinitive); ok {
 		pt := ps.build()
+		source := "irregular lookup"
 		if prefix != "" {
-			pt = applyPrefixToPresent(prefix, pt)
+			base := strings.TrimPrefix(infinitive, prefix)
+			source = prefixSource(prefix, base)
+			pt = applyPrefixToPresent(insertEpentheticVowelForPresent(prefix, base, pt.Sg1), pt)
+		}
+		return []ExplainedParadigm{{Paradigm: Paradigm{PresentTense: pt}, Source: source}}, nil
+	}
+
+	// Archaic -ąc/-ęc infinitives conjugate as their modern -nąć verb:
+	// przysiąc → przysięgnę. Other -ąc/-ęc infinitives have no present.
+	if isArchaicAcShape(infinitive) {
+		modern, ok := modernAcEquivalent(infinitive)
+		if !ok {
+			return nil, &ConjugationError{Infinitive: infinitive, Form: "present"}
 		}
-		return []Paradigm{{PresentTense: pt}}, nil
+		explained, err := conjugatePresentExplained(modern)
+		if err != nil {
+			return nil, err
+		}
+		for i := range explained {
+			explained[i].Source = "archaic:" + modern
+		}
+		return explained, nil
+	}
+
+	// -ywać verbs attested both ways: przekonywać → przekonuję, przekonywam
+	if paradigms, ok := ywacVariants(infinitive); ok {
+		explained := make([]ExplainedParadigm, len(paradigms))
+		for i, p := range paradigms {
+			explained[i] = ExplainedParadigm{Paradigm: p, Source: "heuristicYwacIwac"}
+		}
+		return explained, nil
 	}
 
 	// Try heuristics in order of specificity
 	for _, h := range heuristics {
-		if p, ok := h(infinitive); ok {
-			return []Paradigm{{PresentTense: p}}, nil
+		if p, ok := h.fn(infinitive); ok {
+			paradigm := Paradigm{PresentTense: p, Confidence: heuristicConfidence(h.name)}
+			return []ExplainedParadigm{{Paradigm: paradigm, Source: h.name}}, nil
 		}
 	}
-	return nil, fmt.Errorf("no heuristic matched: %s", infinitive)
+	return nil, &ConjugationError{Infinitive: infinitive, Form: "present"}
+}
+
+// prefixSource names a prefixed irregular: prefix:przy+base:pisać.
+func prefixSource(prefix, base string) string {
+	return "prefix:" + prefix + "+base:" + base
 }
 
 // heuristic is a function that attempts to conjugate a verb.
 // Returns (paradigm, true) if it can handle the verb, (_, false) otherwise.
 type heuristic func(infinitive string) (PresentTense, bool)
 
+// namedHeuristic pairs a heuristic with its function name, reported by
+// ConjugatePresentExplained.
+type namedHeuristic struct {
+	name string
+	fn   heuristic
+}
+
 // heuristics is the ordered list of conjugation heuristics.
 // More specific patterns should come first.
-var heuristics = []heuristic{
+var heuristics = []namedHeuristic{
 	// -ować verbs: pracować → pracuję
-	heuristicOwac,
+	{"heuristicOwac", heuristicOwac},
 	// -ywać/-iwać verbs: pokazywać → pokazuję (but bywać → bywam)
-	heuristicYwacIwac,
+	{"heuristicYwacIwac", heuristicYwacIwac},
 	// -awać verbs: dawać → daję
-	heuristicAwac,
+	{"heuristicAwac", heuristicAwac},
 	// -otać verbs: chichotać → chichoczę
-	heuristicOtac,
+	{"heuristicOtac", heuristicOtac},
 	// -eptać verbs: szeptać → szepczę
-	heuristicEptac,
-	// -łamać verbs: łamać → łamię
-	heuristicLamac,
+	{"heuristicEptac", heuristicEptac},
 	// -dziać verbs (dress): odziać → odzieję
-	heuristicDziac,
+	{"heuristicDziac", heuristicDziac},
 	// -chlać verbs: chlać → chleję
-	heuristicChlac,
+	{"heuristicChlac", heuristicChlac},
 	// -iać verbs: siać → sieję
-	heuristicIac,
+	{"heuristicIac", heuristicIac},
 	// -grzać verbs: grzać → grzeję
-	heuristicGrzac,
+	{"heuristicGrzac", heuristicGrzac},
 	// -ssać verbs: ssać → ssę
-	heuristicSsac,
+	{"heuristicSsac", heuristicSsac},
 	// -ać verbs with consonant alternations: pisać → piszę
-	heuristicAcAlternating,
+	{"heuristicAcAlternating", heuristicAcAlternating},
 	// -nąć verbs: ciągnąć → ciągnę
-	heuristicNac,
+	{"heuristicNac", heuristicNac},
 	// -ąść verbs: trząść → trzęsę, siąść → siądę
-	heuristicAsc,
+	{"heuristicAsc", heuristicAsc},
 	// -jść verbs (from iść): przejść → przejdę
-	heuristicJsc,
+	{"heuristicJsc", heuristicJsc},
 	// -być verbs (perfective): zdobyć → zdobędę
-	heuristicByc,
+	{"heuristicByc", heuristicByc},
 	// -ciąć verbs: rozciąć → rozetnę (suppletive tn- with e-insertion)
-	heuristicCiac,
+	{"heuristicCiac", heuristicCiac},
 	// -giąć verbs: giąć → gnę
-	heuristicGiac,
+	{"heuristicGiac", heuristicGiac},
+	// -cząć verbs: zacząć → zacznę
+	{"heuristicCzac", heuristicCzac},
+	// other -ąć verbs: piąć → pnę, dąć → dmę
+	{"heuristicNasalAc", heuristicNasalAc},
 	// -paść verbs: paść → padnę
-	heuristicPasc,
+	{"heuristicPasc", heuristicPasc},
 	// -stać verbs (get/cease): dostać → dostanę
-	heuristicStacNastal,
+	{"heuristicStacNastal", heuristicStacNastal},
 	// -biec verbs: pobiec → pobiegnę
-	heuristicBiec,
+	{"heuristicBiec", heuristicBiec},
 	// -słać verbs (send): wysłać → wyślę
-	heuristicSlac,
+	{"heuristicSlac", heuristicSlac},
+	// -eć verbs listed as inchoative or action verbs: starzeć → starzeję,
+	// patrzeć → patrzę
+	{"heuristicEcTable", heuristicEcTable},
+	// -przeć/-mrzeć/-wrzeć with stacked prefixes: wesprzeć → wesprę
+	{"heuristicRzecStacked", heuristicRzecStacked},
 	// -trzeć inchoative verbs: wietrzeć → wietrzeję (NOT action verbs like trzeć/drzeć)
-	heuristicTrzecInchoative,
+	{"heuristicTrzecInchoative", heuristicTrzecInchoative},
 	// -trzeć/-drzeć action verbs: trzeć → trę
-	heuristicTrzec,
+	{"heuristicTrzec", heuristicTrzec},
 	// -ść/-źć verbs: nieść → niosę
-	heuristicSc,
+	{"heuristicSc", heuristicSc},
 	// -c verbs: móc → mogę
-	heuristicC,
+	{"heuristicC", heuristicC},
 	// -ić verbs: robić → robię (with consonant alternations)
-	heuristicIc,
+	{"heuristicIc", heuristicIc},
 	// -yć verbs: myć → myję
-	heuristicYc,
+	{"heuristicYc", heuristicYc},
 	// -uć verbs: czuć → czuję
-	heuristicUc,
-	// -eć verbs: umieć → umiem
-	heuristicEc,
+	{"heuristicUc", heuristicUc},
+	// Other -eć verbs: umieć → umiem, biednieć → biednieję
+	{"heuristicEc", heuristicEc},
 	// Regular -ać verbs: czytać → czytam (fallback for -ać)
-	heuristicAc,
+	{"heuristicAc", heuristicAc},
 }
 
 // heuristicOwac handles -ować verbs.
 // pracować → pracuję, pracujesz, pracuje, pracujemy, pracujecie, pracują
+// Stems listed in owamStems keep the -ow- and take -am: chować → chowam.
 func heuristicOwac(infinitive string) (PresentTense, bool) {
 	if !strings.HasSuffix(infinitive, "ować") {
 		return PresentTense{}, false
 	}
 	stem := strings.TrimSuffix(infinitive, "ować")
+	if keepsOwam(stem) {
+		return heuristicAc(infinitive)
+	}
 	return PresentTense{
 		Sg1: stem + "uję",
 		Sg2: stem + "ujesz",
@@ -267,34 +485,69 @@ func heuristicOwac(infinitive string) (PresentTense, bool) {
 	}, true
 }
 
+// owamStems lists the -ować stems (without -ować) whose -ow- belongs to
+// the root, so the verb conjugates like czytać: chować → chowam, not
+// chuję. Prefixed verbs share their base's entry: wychować, zachować,
+// pochować, schować.
+var owamStems = map[string]bool{
+	"ch": true,
+}
+
+// keepsOwam reports whether an -ować stem is a listed -owam stem, bare or
+// behind a single prefix: wych, przech. Stacked prefixes are not tried,
+// since the listed stems are short enough to end other words: szachować
+// (s+za+ch) and zuchować (z+u+ch) take -uję.
+func keepsOwam(stem string) bool {
+	for base := range owamStems {
+		if prefix, ok := strings.CutSuffix(stem, base); ok && (prefix == "" || slices.Contains(verbPrefixes, prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
 // heuristicYwacIwac handles -ywać and -iwać verbs.
 // pokazywać → pokazuję (drop -ywać, add -uję)
 // Exception: bywać, pływać, etc. → bywam (keep stem, -am/-asz)
 func heuristicYwacIwac(infinitive string) (PresentTense, bool) {
-	var stem string
-	if strings.HasSuffix(infinitive, "ywać") {
-		stem = strings.TrimSuffix(infinitive, "ywać")
-	} else if strings.HasSuffix(infinitive, "iwać") {
-		stem = strings.TrimSuffix(infinitive, "iwać")
-	} else {
+	stem, ok := ywacStem(infinitive)
+	if !ok {
 		return PresentTense{}, false
 	}
 
 	// Check if this verb conjugates as -wam/-wasz (not -uję)
 	// Pattern-based: certain stem endings indicate -wam conjugation
 	if usesYwacWamPattern(infinitive, stem) {
-		fullStem := strings.TrimSuffix(infinitive, "ć")
-		return PresentTense{
-			Sg1: fullStem + "m",
-			Sg2: fullStem + "sz",
-			Sg3: fullStem,
-			Pl1: fullStem + "my",
-			Pl2: fullStem + "cie",
-			Pl3: fullStem + "ją",
-		}, true
+		return ywacWamForms(infinitive), true
 	}
 
 	// Standard -ywać/-iwać → -uję pattern
+	return ywacUjeForms(stem), true
+}
+
+// ywacStem returns the stem of an -ywać or -iwać verb: pokazywać → pokaz.
+func ywacStem(infinitive string) (string, bool) {
+	if stem, ok := strings.CutSuffix(infinitive, "ywać"); ok {
+		return stem, true
+	}
+	return strings.CutSuffix(infinitive, "iwać")
+}
+
+// ywacWamForms keeps the -ywa- stem: bywać → bywam, bywasz.
+func ywacWamForms(infinitive string) PresentTense {
+	fullStem := strings.TrimSuffix(infinitive, "ć")
+	return PresentTense{
+		Sg1: fullStem + "m",
+		Sg2: fullStem + "sz",
+		Sg3: fullStem,
+		Pl1: fullStem + "my",
+		Pl2: fullStem + "cie",
+		Pl3: fullStem + "ją",
+	}
+}
+
+// ywacUjeForms replaces -ywa- with -uj-: pokaz → pokazuję, pokazujesz.
+func ywacUjeForms(stem string) PresentTense {
 	return PresentTense{
 		Sg1: stem + "uję",
 		Sg2: stem + "ujesz",
@@ -302,7 +555,38 @@ func heuristicYwacIwac(infinitive string) (PresentTense, bool) {
 		Pl1: stem + "ujemy",
 		Pl2: stem + "ujecie",
 		Pl3: stem + "ują",
-	}, true
+	}
+}
+
+// dualYwacVerbs lists -ywać verbs attested with both the -uję and the
+// -wam present: przekonywać → przekonuję and, less often, przekonywam.
+// The heuristic alone would commit to one of them. A true value marks a
+// -wam present heard only colloquially (wykonywam), which is returned
+// with Low confidence.
+var dualYwacVerbs = map[string]bool{
+	"przekonywać": false,
+	"dokonywać":   true, "pokonywać": true, "wykonywać": true,
+}
+
+// ywacVariants returns both paradigms of a verb in dualYwacVerbs, the
+// one heuristicYwacIwac picks first. The glosses name the pattern rather
+// than a meaning, since the variants mean the same.
+func ywacVariants(infinitive string) ([]Paradigm, bool) {
+	colloquial, ok := dualYwacVerbs[infinitive]
+	if !ok {
+		return nil, false
+	}
+	stem, _ := ywacStem(infinitive)
+	confidence := heuristicConfidence("heuristicYwacIwac")
+	uje := Paradigm{PresentTense: ywacUjeForms(stem), Gloss: "-uję variant", Confidence: confidence}
+	wam := Paradigm{PresentTense: ywacWamForms(infinitive), Gloss: "-wam variant", Confidence: confidence}
+	if colloquial {
+		wam.Gloss, wam.Confidence = "-wam variant (colloquial)", Low
+	}
+	if usesYwacWamPattern(infinitive, stem) {
+		return []Paradigm{wam, uje}, true
+	}
+	return []Paradigm{uje, wam}, true
 }
 
 // usesYwacWamPattern determines if a -ywać verb conjugates as -wam/-wasz
@@ -472,19 +756,39 @@ func isPrefixedZywac(infinitive string) bool {
 
 // canStripAllPrefixes returns true if the string consists only of valid prefixes
 func canStripAllPrefixes(s string) bool {
-	if s == "" {
-		return true
-	}
-	// Try each prefix
-	for _, p := range verbalPrefixes {
-		if strings.HasPrefix(s, p) {
-			rest := strings.TrimPrefix(s, p)
-			if canStripAllPrefixes(rest) {
-				return true
+	return canStripPrefixes(s, verbalPrefixes)
+}
+
+// canStripPrefixes returns true if the string consists only of prefixes from the list.
+// ok[i] records whether s[:i] splits into prefixes, so the check is linear
+// in len(s) rather than exponential in ambiguous splits like po+do / pod+o.
+func canStripPrefixes(s string, prefixes []string) bool {
+	ok := make([]bool, len(s)+1)
+	ok[0] = true
+	for i := range len(s) {
+		if !ok[i] {
+			continue
+		}
+		for _, p := range prefixes {
+			if strings.HasPrefix(s[i:], p) {
+				ok[i+len(p)] = true
 			}
 		}
 	}
-	return false
+	return ok[len(s)]
+}
+
+// stacPrefixes lists every prefix, stacked or not, that builds a -stać
+// "become/cease" verb. It is a closed list rather than a prefix chain
+// because short prefixes chain into unrelated stems: s+z+a would read
+// szastać as a stać compound.
+var stacPrefixes = map[string]bool{
+	"do": true, "na": true, "o": true, "ob": true, "od": true, "po": true,
+	"pod": true, "prze": true, "przy": true, "roz": true, "u": true, "w": true,
+	"wy": true, "za": true, "zo": true,
+	"poprze": true, "pow": true, "pozo": true, "przedo": true, "przezo": true,
+	"przyzo": true, "wydo": true, "zaprze": true,
+	"zmartwychw": true, "zmartwychpow": true,
 }
 
 // heuristicAwac handles -awać verbs (not -ować or -ywać).
@@ -510,59 +814,40 @@ func heuristicAwac(infinitive string) (PresentTense, bool) {
 
 // heuristicOtac handles -otać verbs (onomatopoeia, iterative actions).
 // chichotać → chichoczę, chichoczesz, chichocze...
-// The t→cz alternation occurs in these verbs.
+// The t→cz alternation runs through the whole paradigm. Verbs that also
+// take a regular -otam variant are listed in homographs.
 func heuristicOtac(infinitive string) (PresentTense, bool) {
 	if !strings.HasSuffix(infinitive, "otać") {
 		return PresentTense{}, false
 	}
 	stem := strings.TrimSuffix(infinitive, "tać")
-	// -otać verbs: t→cz in 1sg/3pl, t→c elsewhere
-	// chichotać → chichoczę, chichocesz, chichoce, chichocemy, chichocecie, chichoczą
 	return PresentTense{
 		Sg1: stem + "czę",
-		Sg2: stem + "cesz",
-		Sg3: stem + "ce",
-		Pl1: stem + "cemy",
-		Pl2: stem + "cecie",
+		Sg2: stem + "czesz",
+		Sg3: stem + "cze",
+		Pl1: stem + "czemy",
+		Pl2: stem + "czecie",
 		Pl3: stem + "czą",
 	}, true
 }
 
 // heuristicEptac handles -eptać verbs (and similar -ptać patterns).
-// szeptać → szepczę, szepcesz, szepce (pt→pcz in 1sg/3pl, pt→pc elsewhere)
+// szeptać → szepczę, szepczesz, szepcze (pt→pcz throughout)
 func heuristicEptac(infinitive string) (PresentTense, bool) {
 	if !strings.HasSuffix(infinitive, "ptać") {
 		return PresentTense{}, false
 	}
 	stem := strings.TrimSuffix(infinitive, "tać")
-	// pt→pcz in 1sg/3pl, pt→pc in others
 	return PresentTense{
 		Sg1: stem + "czę",
-		Sg2: stem + "cesz",
-		Sg3: stem + "ce",
-		Pl1: stem + "cemy",
-		Pl2: stem + "cecie",
+		Sg2: stem + "czesz",
+		Sg3: stem + "cze",
+		Pl1: stem + "czemy",
+		Pl2: stem + "czecie",
 		Pl3: stem + "czą",
 	}, true
 }
 
-// heuristicLamac handles -łamać and -kłamać verbs.
-// łamać → łamię, łamiesz, łamie (m→mi alternation)
-func heuristicLamac(infinitive string) (PresentTense, bool) {
-	if !strings.HasSuffix(infinitive, "łamać") && !strings.HasSuffix(infinitive, "kłamać") {
-		return PresentTense{}, false
-	}
-	stem := strings.TrimSuffix(infinitive, "ać")
-	return PresentTense{
-		Sg1: stem + "ię",
-		Sg2: stem + "iesz",
-		Sg3: stem + "ie",
-		Pl1: stem + "iemy",
-		Pl2: stem + "iecie",
-		Pl3: stem + "ią",
-	}, true
-}
-
 // heuristicDziac handles -dziać verbs (to dress/put on).
 // odziać → odzieję, wdziać → wdzieję, przyodziać → przyodzieję
 // These use a→ie alternation before j, similar to siać/ziać.
@@ -675,6 +960,9 @@ func heuristicSsac(infinitive string) (PresentTense, bool) {
 //   -sać: 82 alternate vs 142 regular → mostly regular (skip)
 //   -zać: 100 alternate vs 1494 regular → mostly regular (skip)
 //   -kać: 77 alternate vs 722 regular → mostly regular (skip)
+//
+// The minority that alternate in the mostly regular classes are listed in
+// alternatingAcStems.
 func heuristicAcAlternating(infinitive string) (PresentTense, bool) {
 	if !strings.HasSuffix(infinitive, "ać") {
 		return PresentTense{}, false
@@ -691,6 +979,17 @@ func heuristicAcAlternating(infinitive string) (PresentTense, bool) {
 
 	stem := strings.TrimSuffix(infinitive, "ać")
 
+	// Listed stems, bare or prefixed: pisać → piszę, przekazać → przekażę
+	if alternatesAc(stem) {
+		// -m softens to -mi like -p and -b below: łamać → łamię
+		if strings.HasSuffix(stem, "m") {
+			return presentIEIesz(stem), true
+		}
+		if soft, ok := applySoftening(stem); ok {
+			return presentEEsz(soft), true
+		}
+	}
+
 	// Only match patterns that mostly alternate (>80% alternation rate)
 
 	// -pać → -pię: capać → capię, sypać → sypię (95% alternate)
@@ -707,6 +1006,36 @@ func heuristicAcAlternating(infinitive string) (PresentTense, bool) {
 	return PresentTense{}, false
 }
 
+// alternatingAcStems lists the -ać stems that take -ę/-esz with a softened
+// consonant although most verbs with their ending take -am: pisać → piszę,
+// kazać → każę, skakać → skaczę, karać → karzę, łamać → łamię. Prefixed
+// verbs share their base's entry.
+var alternatingAcStems = map[string]bool{
+	// -sać: s→sz
+	"pis": true, "czes": true, "kołys": true,
+	// -zać: z→ż
+	"wiąz": true, "kaz": true, "maz": true, "liz": true,
+	// -kać: k→cz
+	"skak": true, "płak": true,
+	// -gać: g→ż
+	"łg": true,
+	// -rać: r→rz
+	"kar": true, "or": true,
+	// -mać: m→mi (trzymać, dumać, dymać stay regular)
+	"łam": true, "kłam": true, "łom": true, "drzem": true, "drzym": true,
+}
+
+// alternatesAc reports whether an -ać stem is a listed alternating stem,
+// possibly behind prefixes: przepis, wskaz (w+s+kaz).
+func alternatesAc(stem string) bool {
+	for base := range alternatingAcStems {
+		if prefixes, ok := strings.CutSuffix(stem, base); ok && canStripAllPrefixes(prefixes) {
+			return true
+		}
+	}
+	return false
+}
+
 // presentEEsz creates a present tense paradigm with -ę/-esz endings.
 // Used for verbs like pisać → piszę, piszesz, pisze...
 func presentEEsz(stem string) PresentTense {
@@ -746,9 +1075,9 @@ func heuristicNac(infinitive string) (PresentTense, bool) {
 	sg1Stem := stem
 	pl3Stem := stem
 
-	// For other forms, soften consonant before 'n' if applicable
-	// sn → śn, zn → źn before front vowels
-	softStem := softenBeforeN(stem)
+	// For other forms, soften the consonant before the now soft n:
+	// przyśniesz, liźniesz (but pełzniesz, marzniesz)
+	softStem := softenBeforeN(strings.TrimSuffix(stem, "n")) + "n"
 
 	return PresentTense{
 		Sg1: sg1Stem + "ę",
@@ -760,30 +1089,6 @@ func heuristicNac(infinitive string) (PresentTense, bool) {
 	}, true
 }
 
-// softenBeforeN softens consonants before 'n' at end of stem
-// Rules:
-// - sn → śn always
-// - zn → źn only when preceded by front vowel (i, ę)
-//   e.g., grzęznąć → grzęźnie, obliznąć → obliźnie
-//   but pełznąć → pełznie (ł is not a front vowel)
-func softenBeforeN(stem string) string {
-	if strings.HasSuffix(stem, "sn") {
-		return strings.TrimSuffix(stem, "sn") + "śn"
-	}
-	// For zn, check if preceded by front vowel
-	if strings.HasSuffix(stem, "zn") {
-		runes := []rune(stem)
-		if len(runes) >= 3 {
-			vowelBefore := runes[len(runes)-3]
-			// Front vowels that trigger softening: i, ę
-			if vowelBefore == 'i' || vowelBefore == 'ę' {
-				return strings.TrimSuffix(stem, "zn") + "źn"
-			}
-		}
-	}
-	return stem
-}
-
 // heuristicAsc handles -ąść verbs.
 // Three main patterns:
 // - siąść type: usiąść → usiądę, usiądziesz, usiądzie (ą stays, ść→dzie)
@@ -873,6 +1178,12 @@ func heuristicByc(infinitive string) (PresentTense, bool) {
 		return PresentTense{}, false
 	}
 	// Perfective -być verbs use będ- stem
+	return buildBedPresent(prefix), true
+}
+
+// buildBedPresent builds the będ- paradigm: the future of być (będę) and
+// the non-past of its perfective compounds (zdobędę).
+func buildBedPresent(prefix string) PresentTense {
 	return PresentTense{
 		Sg1: prefix + "będę",
 		Sg2: prefix + "będziesz",
@@ -880,7 +1191,7 @@ func heuristicByc(infinitive string) (PresentTense, bool) {
 		Pl1: prefix + "będziemy",
 		Pl2: prefix + "będziecie",
 		Pl3: prefix + "będą",
-	}, true
+	}
 }
 
 // heuristicCiac handles -ciąć verbs (to cut).
@@ -937,12 +1248,13 @@ func heuristicCiac(infinitive string) (PresentTense, bool) {
 
 // heuristicGiac handles -giąć verbs.
 // giąć → gnę, gniesz, gnie (i→n, ą→ę)
-// zagiąć → zagnę, wygiąć → wygnę
+// zagiąć → zagnę, wygiąć → wygnę, zgiąć → zegnę
 func heuristicGiac(infinitive string) (PresentTense, bool) {
-	if !strings.HasSuffix(infinitive, "giąć") {
+	prefix, ok := strings.CutSuffix(infinitive, "giąć")
+	if !ok {
 		return PresentTense{}, false
 	}
-	prefix := strings.TrimSuffix(infinitive, "iąć") // keeps 'g'
+	prefix = insertEpentheticVowelForPresent(prefix, "giąć", "gnę") + "g"
 	return PresentTense{
 		Sg1: prefix + "nę",
 		Sg2: prefix + "niesz",
@@ -953,6 +1265,51 @@ func heuristicGiac(infinitive string) (PresentTense, bool) {
 	}, true
 }
 
+// heuristicCzac handles -cząć verbs.
+// cząć → cznę, czniesz, cznie (ą drops, n-insertion: cz-n stem)
+// zacząć → zacznę, rozpocząć → rozpocznę, napocząć → napocznę
+func heuristicCzac(infinitive string) (PresentTense, bool) {
+	if !strings.HasSuffix(infinitive, "cząć") {
+		return PresentTense{}, false
+	}
+	prefix := strings.TrimSuffix(infinitive, "ąć") // keeps 'cz'
+	return presentSpec{sg13: prefix + "n", stem: prefix + "ni", class: ConjI}.build(), true
+}
+
+// heuristicNasalAc handles the remaining -ąć verbs, whose nasal vowel
+// becomes a nasal consonant in the present: -n- in most roots (piąć →
+// pnę, miąć → mnę, żąć → żnę), so prefixed forms need no table entry
+// (zapiąć → zapnę), and -m- after d and j (dąć → dmę, nadąć → nadmę,
+// przyjąć → przyjmę). A consonant-final prefix takes e before the new
+// cluster: zmiąć → zemnę, zżąć → zeżnę, zdąć → zedmę, objąć → obejmę.
+// The wziąć family is suppletive (przedsięwezmę) and left to the
+// irregular table.
+func heuristicNasalAc(infinitive string) (PresentTense, bool) {
+	stem, ok := strings.CutSuffix(infinitive, "ąć")
+	if !ok || strings.HasSuffix(stem, "wzi") {
+		return PresentTense{}, false
+	}
+	stem = strings.TrimSuffix(stem, "i") // pi-ąć, mi-ąć: i only marks softness
+	if stem == "" {
+		return PresentTense{}, false
+	}
+	root, size := utf8.DecodeLastRuneInString(stem)
+	prefix := stem[:len(stem)-size]
+	cluster := string(root) + "n"
+	if root == 'd' || root == 'j' {
+		cluster = string(root) + "m"
+	}
+	if prefix != "" {
+		prefix = insertEpentheticVowelForPresent(prefix, strings.TrimPrefix(infinitive, prefix), cluster+"ę")
+		// jm- takes e after any consonant, including the d of zdjąć
+		if last, _ := utf8.DecodeLastRuneInString(prefix); root == 'j' && !isPolishVowel(last) {
+			prefix += "e"
+		}
+	}
+	stem = prefix + cluster
+	return presentSpec{sg13: stem, stem: stem + "i", class: ConjI}.build(), true
+}
+
 // heuristicPasc handles -paść verbs.
 // paść → padnę, padniesz, padnie (ść→dnę - d-insertion)
 // upaść → upadnę, napaść → napadnę, wpaść → wpadnę
@@ -975,8 +1332,9 @@ func heuristicPasc(infinitive string) (PresentTense, bool) {
 // dostać → dostanę, przestać → przestanę, powstać → powstanę
 // These use -stanę/-staniesz pattern (n-insertion), unlike stać "stand" → stoję
 //
-// We only match if the prefix is a known verbal prefix (do-, prze-, po-, etc.)
-// to avoid matching verbs like świstać, podrastać which are not from stać.
+// We only match if the part before -stać is a known stać prefix (see
+// stacPrefixes) to avoid matching verbs like świstać, podrastać, szastać
+// which are not from stać.
 func heuristicStacNastal(infinitive string) (PresentTense, bool) {
 	if !strings.HasSuffix(infinitive, "stać") {
 		return PresentTense{}, false
@@ -987,17 +1345,8 @@ func heuristicStacNastal(infinitive string) (PresentTense, bool) {
 		return PresentTense{}, false
 	}
 
-	// Only match if prefix is a known verbal prefix (nothing extra)
-	// Valid: dostać (do-), przestać (prze-), powstać (po-), nastać (na-), etc.
-	// Invalid: świstać (świ- is not a prefix), podrastać (podra- is not a prefix)
-	validPrefixes := map[string]bool{
-		"do": true, "na": true, "o": true, "ob": true, "od": true, "ode": true,
-		"po": true, "pod": true, "pode": true, "prze": true, "przy": true,
-		"roz": true, "u": true, "w": true, "wy": true, "z": true, "za": true,
-		"zo": true, "ze": true, "wz": true, "ws": true, "pow": true,
-	}
-
-	if !validPrefixes[prefix] {
+	// powstać is po+wstać, pozostać po+zostać, zaprzestać za+przestać.
+	if !stacPrefixes[prefix] {
 		return PresentTense{}, false
 	}
 
@@ -1048,6 +1397,26 @@ func heuristicSlac(infinitive string) (PresentTense, bool) {
 	}, true
 }
 
+// stackedRzecBases are the irregular -rzeć bases that also take stacked
+// prefixes, which lookupIrregularPresent (one prefix only) misses.
+// Stacked patrzeć (zaopatrzeć → zaopatrzę) is in actionEcVerbs.
+var stackedRzecBases = []string{"przeć", "mrzeć", "wrzeć"}
+
+// heuristicRzecStacked handles -rzeć verbs whose prefix is a stack of
+// prefixes over an irregular base:
+// wesprzeć (we+s) → wesprę, obumrzeć (ob+u) → obumrę
+func heuristicRzecStacked(infinitive string) (PresentTense, bool) {
+	for _, base := range stackedRzecBases {
+		prefix, ok := strings.CutSuffix(infinitive, base)
+		if !ok || prefix == "" || !canStripPrefixes(prefix, verbPrefixes) {
+			continue
+		}
+		ps := *irregularSpecs[base].present
+		return applyPrefixToPresent(prefix, ps.build()), true
+	}
+	return PresentTense{}, false
+}
+
 // heuristicTrzecInchoative handles inchoative -trzeć and -drzeć verbs (becoming something).
 // wietrzeć → wietrzeję (to weather), filistrzeć → filistrzeję
 // modrzeć → modrzeję (to become blue), mądrzeć → mądrzeję (to become wiser)
@@ -1082,13 +1451,9 @@ func heuristicTrzecInchoative(infinitive string) (PresentTense, bool) {
 	// Inchoative: wietrzeć, filistrzeć, lustrzeć, chytrzeć, pstrzeć
 	stem := strings.TrimSuffix(infinitive, "trzeć")
 
-	// Simple prefixed action verbs have empty stem or single prefix
-	actionPrefixes := map[string]bool{
-		"": true, "ze": true, "wy": true, "prze": true, "za": true,
-		"ob": true, "u": true, "na": true, "po": true, "o": true,
-		"obe": true, "do": true, "s": true, "roz": true, "roze": true,
-	}
-	if actionPrefixes[stem] {
+	// Prefixed action verbs have a stem made only of prefixes, including
+	// the epenthetic ones: wetrzeć, odetrzeć, rozpostrzeć (roz+po+s)
+	if canStripPrefixes(stem, verbPrefixes) {
 		return PresentTense{}, false
 	}
 
@@ -1154,6 +1519,14 @@ func heuristicTrzec(infinitive string) (PresentTense, bool) {
 // wieźć → wiozę, wieziesz, wiezie...
 // gryźć → gryzę, gryziesz, gryzie...
 func heuristicSc(infinitive string) (PresentTense, bool) {
+	// -kłaść and -kraść verbs: suppletive kład-/kradn- stems, bare or
+	// after any prefixes. kłaść → kładę, ukraść → ukradnę
+	if prefix, ok := strings.CutSuffix(infinitive, "kłaść"); ok && canStripAllPrefixes(prefix) {
+		return presentSpec{sg13: prefix + "kład", stem: prefix + "kładzi", class: ConjI}.build(), true
+	}
+	if prefix, ok := strings.CutSuffix(infinitive, "kraść"); ok && canStripAllPrefixes(prefix) {
+		return presentSpec{sg13: prefix + "kradn", stem: prefix + "kradni", class: ConjI}.build(), true
+	}
 	// -mieść verbs: ie→io, ś→t in 1sg/3pl, ś→c elsewhere
 	// mieść → miotę, mieciesz, miecie
 	if strings.HasSuffix(infinitive, "mieść") {
@@ -1381,7 +1754,9 @@ func heuristicYc(infinitive string) (PresentTense, bool) {
 		}, true
 	}
 
-	// Polysyllabic stems ending in soft consonant (cz, sz, ż, rz) use -ę/-ysz
+	// Polysyllabic stems ending in soft consonant (cz, sz, ż, rz) use -ę/-ysz.
+	// Plain r is hard, so prefixed ryć keeps j-insertion: wyryć → wyryję
+	// (stem wyr) but burzyć → burzę (stem burz).
 	if endsInSoftConsonant(stem) {
 		return PresentTense{
 			Sg1: stem + "ę",
@@ -1432,6 +1807,13 @@ func heuristicEc(infinitive string) (PresentTense, bool) {
 		return PresentTense{}, false
 	}
 
+	// -wiedzieć family: wiedzieć → wiem, powiedzieć → powiem (Class IV
+	// with wiedz- in pl3). Checked before the other -eć rules so none of
+	// them can claim it.
+	if prefix, ok := strings.CutSuffix(infinitive, "wiedzieć"); ok {
+		return presentSpec{stem: prefix + "wie", sg13: prefix + "wiedz", class: ConjIV}.build(), true
+	}
+
 	// Most -ieć verbs conjugate as -ieję/-iejesz (891 vs 26)
 	if strings.HasSuffix(infinitive, "ieć") {
 		// -umieć family: umieć → umiem (Class IV)
@@ -1439,18 +1821,14 @@ func heuristicEc(infinitive string) (PresentTense, bool) {
 			stem := strings.TrimSuffix(infinitive, "ć")
 			return presentSpec{stem: stem, class: ConjIV}.build(), true
 		}
-		// -wiedzieć family: wiedzieć → wiem (Class IV with irregular pl3)
-		if strings.HasSuffix(infinitive, "wiedzieć") {
-			stem := strings.TrimSuffix(infinitive, "iedzieć")
-			return presentSpec{stem: stem + "ie", sg13: stem + "iedz", class: ConjIV}.build(), true
-		}
 		// śmieć: śmieć → śmiem (Class IV)
 		if infinitive == "śmieć" || strings.HasSuffix(infinitive, "ośmieć") {
 			stem := strings.TrimSuffix(infinitive, "ć")
 			return presentSpec{stem: stem, class: ConjIV}.build(), true
 		}
-		// chcieć: chcieć → chcę (special -ę/-esz pattern)
-		if strings.HasSuffix(infinitive, "chcieć") {
+		// chcieć: chcieć → chcę (special -ę/-esz pattern), with prefixes
+		// only: szlachcieć is an ordinary inchoative (szlachcieję)
+		if prefix, ok := strings.CutSuffix(infinitive, "chcieć"); ok && canStripPrefixes(prefix, verbPrefixes) {
 			stem := strings.TrimSuffix(infinitive, "ieć")
 			return PresentTense{
 				Sg1: stem + "ę",
@@ -1465,45 +1843,6 @@ func heuristicEc(infinitive string) (PresentTense, bool) {
 		if infinitive == "mieć" {
 			return PresentTense{}, false
 		}
-		// -idzieć verbs (widzieć family): use -ę/-isz pattern
-		// widzieć → widzę, nienawidzieć → nienawidzę
-		if strings.HasSuffix(infinitive, "idzieć") {
-			stem := strings.TrimSuffix(infinitive, "ieć")
-			return PresentTense{
-				Sg1: stem + "ę",
-				Sg2: stem + "isz",
-				Sg3: stem + "i",
-				Pl1: stem + "imy",
-				Pl2: stem + "icie",
-				Pl3: stem + "ą",
-			}, true
-		}
-		// -edzieć verbs (siedzieć family): use -ę/-isz pattern
-		// siedzieć → siedzę
-		if strings.HasSuffix(infinitive, "edzieć") {
-			stem := strings.TrimSuffix(infinitive, "ieć")
-			return PresentTense{
-				Sg1: stem + "ę",
-				Sg2: stem + "isz",
-				Sg3: stem + "i",
-				Pl1: stem + "imy",
-				Pl2: stem + "icie",
-				Pl3: stem + "ą",
-			}, true
-		}
-		// -lecieć verbs: use -ę/-isz pattern
-		// lecieć → lecę, polecieć → polecę
-		if strings.HasSuffix(infinitive, "lecieć") {
-			stem := strings.TrimSuffix(infinitive, "ieć")
-			return PresentTense{
-				Sg1: stem + "ę",
-				Sg2: stem + "isz",
-				Sg3: stem + "i",
-				Pl1: stem + "imy",
-				Pl2: stem + "icie",
-				Pl3: stem + "ą",
-			}, true
-		}
 		// Standard -ieć → -ieję pattern
 		stem := strings.TrimSuffix(infinitive, "ć")
 		return PresentTense{
@@ -1516,137 +1855,9 @@ func heuristicEc(infinitive string) (PresentTense, bool) {
 		}, true
 	}
 
-	// Verbs ending in soft consonant + -eć have two patterns:
-	// 1. -ę/-isz for action verbs: leżeć → leżę, myśleć → myślę
-	// 2. -eję/-ejesz for inchoative verbs: maleć → maleję, boleć → boleję
-	//
-	// Pattern by ending (based on corpus statistics):
-	// -śleć: 80% use -ę (myśleć type) - action verbs
-	// -dzieć: 59% use -ę (widzieć, siedzieć) - action verbs
-	// -cieć: 85% use -eję, but lecieć uses -ę - mostly inchoative
-	// -szeć: 60% use -eję, but słyszeć uses -ę - mixed
-	// -żeć: 70% use -ę (leżeć type) - action verbs
-	// -rzeć: 55% use -ę (patrzeć type) - action verbs
+	// Other verbs after a soft consonant (-żeć, -rzeć) are mostly action
+	// verbs → -ę/-ysz: leżeć → leżę, leżysz
 	stem := strings.TrimSuffix(infinitive, "eć")
-
-	// -śleć verbs (myśleć family): mostly -ę/-isz (80%)
-	if strings.HasSuffix(infinitive, "śleć") {
-		return PresentTense{
-			Sg1: stem + "ę",
-			Sg2: stem + "isz",
-			Sg3: stem + "i",
-			Pl1: stem + "imy",
-			Pl2: stem + "icie",
-			Pl3: stem + "ą",
-		}, true
-	}
-
-	// -cieć verbs: mostly inchoative -eję pattern (lecieć uses -ę/-isz but
-	// is handled inside the -ieć block above since -lecieć ends in -ieć)
-	if strings.HasSuffix(infinitive, "cieć") {
-		stem := strings.TrimSuffix(infinitive, "ć")
-		return PresentTense{
-			Sg1: stem + "ję",
-			Sg2: stem + "jesz",
-			Sg3: stem + "je",
-			Pl1: stem + "jemy",
-			Pl2: stem + "jecie",
-			Pl3: stem + "ją",
-		}, true
-	}
-
-	// -szeć verbs: słyszeć uses -ę/-ysz, most others use -eję
-	if strings.HasSuffix(infinitive, "słyszeć") {
-		return PresentTense{
-			Sg1: stem + "ę",
-			Sg2: stem + "ysz",
-			Sg3: stem + "y",
-			Pl1: stem + "ymy",
-			Pl2: stem + "ycie",
-			Pl3: stem + "ą",
-		}, true
-	}
-	if strings.HasSuffix(infinitive, "szeć") {
-		stem := strings.TrimSuffix(infinitive, "ć")
-		return PresentTense{
-			Sg1: stem + "ję",
-			Sg2: stem + "jesz",
-			Sg3: stem + "je",
-			Pl1: stem + "jemy",
-			Pl2: stem + "jecie",
-			Pl3: stem + "ją",
-		}, true
-	}
-
-	// -czeć verbs: inchoative verbs like dziczeć, miękczeć → -eję
-	// (not -ę/-ysz which applies to some action -żeć/-rzeć verbs)
-	if strings.HasSuffix(infinitive, "czeć") {
-		stem := strings.TrimSuffix(infinitive, "ć")
-		return PresentTense{
-			Sg1: stem + "ję",
-			Sg2: stem + "jesz",
-			Sg3: stem + "je",
-			Pl1: stem + "jemy",
-			Pl2: stem + "jecie",
-			Pl3: stem + "ją",
-		}, true
-	}
-
-	// -ożeć verbs: inchoative verbs like drożeć, ubożeć, srożeć → -eję
-	if strings.HasSuffix(infinitive, "ożeć") {
-		stem := strings.TrimSuffix(infinitive, "ć")
-		return PresentTense{
-			Sg1: stem + "ję",
-			Sg2: stem + "jesz",
-			Sg3: stem + "je",
-			Pl1: stem + "jemy",
-			Pl2: stem + "jecie",
-			Pl3: stem + "ją",
-		}, true
-	}
-
-	// -urzeć verbs: inchoative verbs like durzeć, burzeć → -eję
-	if strings.HasSuffix(infinitive, "urzeć") {
-		stem := strings.TrimSuffix(infinitive, "ć")
-		return PresentTense{
-			Sg1: stem + "ję",
-			Sg2: stem + "jesz",
-			Sg3: stem + "je",
-			Pl1: stem + "jemy",
-			Pl2: stem + "jecie",
-			Pl3: stem + "ją",
-		}, true
-	}
-
-	// Inchoative -rzeć verbs from adjectives: → -eję
-	// modrzeć (from modry), mądrzeć (from mądry), szarzeć (from szary)
-	// (NOT action verbs like trzeć, drzeć which use -rę)
-	if isInchoativeRzec(infinitive) {
-		stem := strings.TrimSuffix(infinitive, "ć")
-		return PresentTense{
-			Sg1: stem + "ję",
-			Sg2: stem + "jesz",
-			Sg3: stem + "je",
-			Pl1: stem + "jemy",
-			Pl2: stem + "jecie",
-			Pl3: stem + "ją",
-		}, true
-	}
-
-	// Other -leć verbs (maleć, boleć) are mostly inchoative → -eję
-	if strings.HasSuffix(infinitive, "leć") {
-		stem := strings.TrimSuffix(infinitive, "ć")
-		return PresentTense{
-			Sg1: stem + "ję",
-			Sg2: stem + "jesz",
-			Sg3: stem + "je",
-			Pl1: stem + "jemy",
-			Pl2: stem + "jecie",
-			Pl3: stem + "ją",
-		}, true
-	}
-
-	// -żeć, -rzeć verbs are mostly action verbs → -ę/-ysz pattern
 	if endsInSoftConsonant(stem) {
 		return PresentTense{
 			Sg1: stem + "ę",
@@ -1687,26 +1898,6 @@ func heuristicAc(infinitive string) (PresentTense, bool) {
 	}, true
 }
 
-// Inchoative -rzeć verb helpers
-
-// inchoativeRzecRoots lists adjective roots that form inchoative -rzeć verbs.
-// These verbs conjugate with -eję (modrzeję), not -ę like action verbs (drę).
-var inchoativeRzecRoots = []string{
-	"modrzeć",  // from modry (blue) - to become blue
-	"mądrzeć",  // from mądry (wise) - to become wiser
-	"szarzeć",  // from szary (gray) - to become gray
-}
-
-// isInchoativeRzec returns true if the verb is an inchoative -rzeć verb (from adjectives).
-func isInchoativeRzec(infinitive string) bool {
-	for _, root := range inchoativeRzecRoots {
-		if infinitive == root || strings.HasSuffix(infinitive, root) {
-			return true
-		}
-	}
-	return false
-}
-
 // Consonant alternation helpers
 
 // endsInVowel returns true if the stem ends in a vowel.
@@ -1,6 +1,10 @@
 package verb
 
-import "testing"
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
 
 func TestConjugatePresentAc(t *testing.T) {
 	tests := []struct {
@@ -129,3 +133,994 @@ func TestHomographs(t *testing.T) {
 		})
 	}
 }
+
+func TestConjugatePresentDualYwac(t *testing.T) {
+	paradigms, err := ConjugatePresentExplained("przekonywać")
+	if err != nil {
+		t.Fatalf("ConjugatePresentExplained(przekonywać) error: %v", err)
+	}
+	want := []struct {
+		sg1, sg2, gloss string
+	}{
+		{"przekonuję", "przekonujesz", "-uję variant"},
+		{"przekonywam", "przekonywasz", "-wam variant"},
+	}
+	if len(paradigms) != len(want) {
+		t.Fatalf("ConjugatePresentExplained(przekonywać) returned %d paradigms, want %d", len(paradigms), len(want))
+	}
+	for i, w := range want {
+		p := paradigms[i]
+		if p.Sg1 != w.sg1 || p.Sg2 != w.sg2 || p.Gloss != w.gloss {
+			t.Errorf("paradigm %d = %s, %s (%q), want %s, %s (%q)", i, p.Sg1, p.Sg2, p.Gloss, w.sg1, w.sg2, w.gloss)
+		}
+		if p.Source != "heuristicYwacIwac" {
+			t.Errorf("paradigm %d source = %q, want heuristicYwacIwac", i, p.Source)
+		}
+	}
+
+	// Reflexive forms and verbs outside the set keep a single paradigm
+	reflexive, err := ConjugatePresent("przekonywać się")
+	if err != nil || len(reflexive) != 2 || reflexive[1].Sg1 != "przekonywam się" {
+		t.Errorf("ConjugatePresent(przekonywać się) = %v, %v; want both variants", reflexive, err)
+	}
+	// The -wam present of the other -konywać verbs is colloquial
+	wykonywac, err := ConjugatePresent("wykonywać")
+	if err != nil || len(wykonywac) != 2 || wykonywac[1].Sg1 != "wykonywam" ||
+		wykonywac[1].Gloss != "-wam variant (colloquial)" || wykonywac[1].Confidence != Low {
+		t.Errorf("ConjugatePresent(wykonywać) = %+v, %v; want a Low colloquial -wam variant", wykonywac, err)
+	} else if wykonywac[0].Confidence == Low {
+		t.Errorf("ConjugatePresent(wykonywać) -uję variant has Low confidence")
+	}
+	for _, infinitive := range []string{"pokazywać", "bywać"} {
+		got, err := ConjugatePresent(infinitive)
+		if err != nil || len(got) != 1 {
+			t.Errorf("ConjugatePresent(%q) = %v, %v; want one paradigm", infinitive, got, err)
+		}
+	}
+}
+
+func TestConjugatePresentStac(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+	}{
+		// -stać "become/cease": n-insertion
+		{"dostać", "dostanę"},
+		{"zostać", "zostanę"},
+		{"przestać", "przestanę"},
+		{"powstać", "powstanę"},
+		{"wstać", "wstanę"},
+		{"pozostać", "pozostanę"},
+		{"zaprzestać", "zaprzestanę"},
+		{"poprzestać", "poprzestanę"},
+		{"wydostać", "wydostanę"},
+		{"przedostać", "przedostanę"},
+		{"zmartwychwstać", "zmartwychwstanę"},
+
+		// Not built on stać: regular -am
+		{"świstać", "świstam"},
+		{"chlastać", "chlastam"},
+		{"chrzęstać", "chrzęstam"},
+		{"podrastać", "podrastam"},
+		{"korzystać", "korzystam"},
+		{"sprostać", "sprostam"},
+		{"szastać", "szastam"},
+		{"szustać", "szustam"},
+		{"poszastać", "poszastam"},
+		{"rozszastać", "rozszastam"},
+		{"zaszastać", "zaszastam"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			paradigms, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got := paradigms[0].Sg1; got != tt.wantSg1 {
+				t.Errorf("ConjugatePresent(%q).Sg1 = %q, want %q", tt.infinitive, got, tt.wantSg1)
+			}
+		})
+	}
+}
+
+func TestConjugatePresentEcIsz(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSg2    string
+	}{
+		// -eć action verbs: -ę/-isz
+		{"widzieć", "widzę", "widzisz"},
+		{"przewidzieć", "przewidzę", "przewidzisz"},
+		{"nienawidzieć", "nienawidzę", "nienawidzisz"},
+		{"woleć", "wolę", "wolisz"},
+		{"siedzieć", "siedzę", "siedzisz"},
+		{"wzlecieć", "wzlecę", "wzlecisz"},
+		{"pomyśleć", "pomyślę", "pomyślisz"},
+
+		// -ić counterpart
+		{"nienawidzić", "nienawidzę", "nienawidzisz"},
+
+		// Inchoative look-alikes: -eję/-ejesz
+		{"śniedzieć", "śniedzieję", "śniedziejesz"},
+		{"dorośleć", "dorośleję", "doroślejesz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			paradigms, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			got := paradigms[0]
+			if got.Sg1 != tt.wantSg1 || got.Sg2 != tt.wantSg2 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got.Sg1, got.Sg2, tt.wantSg1, tt.wantSg2)
+			}
+		})
+	}
+}
+
+func TestConjugatePresentCzac(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       PresentTense
+	}{
+		{
+			infinitive: "zapocząć",
+			want: PresentTense{
+				Sg1: "zapocznę", Sg2: "zapoczniesz", Sg3: "zapocznie",
+				Pl1: "zapoczniemy", Pl2: "zapoczniecie", Pl3: "zapoczną",
+			},
+		},
+		{
+			infinitive: "nadpocząć",
+			want: PresentTense{
+				Sg1: "nadpocznę", Sg2: "nadpoczniesz", Sg3: "nadpocznie",
+				Pl1: "nadpoczniemy", Pl2: "nadpoczniecie", Pl3: "nadpoczną",
+			},
+		},
+		// Formerly listed individually; now derived by rule
+		{
+			infinitive: "odpocząć",
+			want: PresentTense{
+				Sg1: "odpocznę", Sg2: "odpoczniesz", Sg3: "odpocznie",
+				Pl1: "odpoczniemy", Pl2: "odpoczniecie", Pl3: "odpoczną",
+			},
+		},
+		{
+			infinitive: "rozpocząć",
+			want: PresentTense{
+				Sg1: "rozpocznę", Sg2: "rozpoczniesz", Sg3: "rozpocznie",
+				Pl1: "rozpoczniemy", Pl2: "rozpoczniecie", Pl3: "rozpoczną",
+			},
+		},
+		{
+			infinitive: "spocząć",
+			want: PresentTense{
+				Sg1: "spocznę", Sg2: "spoczniesz", Sg3: "spocznie",
+				Pl1: "spoczniemy", Pl2: "spoczniecie", Pl3: "spoczną",
+			},
+		},
+		{
+			infinitive: "wypocząć",
+			want: PresentTense{
+				Sg1: "wypocznę", Sg2: "wypoczniesz", Sg3: "wypocznie",
+				Pl1: "wypoczniemy", Pl2: "wypoczniecie", Pl3: "wypoczną",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			paradigms, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got := paradigms[0].PresentTense; got != tt.want {
+				t.Errorf("ConjugatePresent(%q) =\n%+v\nwant:\n%+v", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestKlascKrascFamilies checks the suppletive -ść stems across present,
+// past and verbal noun: kłaść has -dę/-dziesz, kraść has -dnę/-dniesz.
+func TestKlascKrascFamilies(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSg3    string
+		wantPast   string
+		wantVN     string
+	}{
+		{"kłaść", "kładę", "kładzie", "kładł", "kładzenie"},
+		{"nakłaść", "nakładę", "nakładzie", "nakładł", "nakładzenie"},
+		{"pokłaść", "pokładę", "pokładzie", "pokładł", "pokładzenie"},
+		{"kraść", "kradnę", "kradnie", "kradł", "kradzenie"},
+		{"ukraść", "ukradnę", "ukradnie", "ukradł", "ukradzenie"},
+		{"wykraść", "wykradnę", "wykradnie", "wykradł", "wykradzenie"},
+		{"rozkraść", "rozkradnę", "rozkradnie", "rozkradł", "rozkradzenie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			present, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got := present[0]; got.Sg1 != tt.wantSg1 || got.Sg3 != tt.wantSg3 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got.Sg1, got.Sg3, tt.wantSg1, tt.wantSg3)
+			}
+
+			past, err := ConjugatePast(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePast(%q) error: %v", tt.infinitive, err)
+			}
+			if got := past[0].Sg3M; got != tt.wantPast {
+				t.Errorf("ConjugatePast(%q).Sg3M = %s, want %s", tt.infinitive, got, tt.wantPast)
+			}
+
+			vn, err := VerbalNoun(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q) error: %v", tt.infinitive, err)
+			}
+			if len(vn) != 1 || vn[0] != tt.wantVN {
+				t.Errorf("VerbalNoun(%q) = %v, want [%s]", tt.infinitive, vn, tt.wantVN)
+			}
+		})
+	}
+}
+
+// TestTworzycFamily checks that otworzyć mixes a regular present
+// (otworzę, via -yć) with the irregular twar- past, while other -tworzyć
+// and -mknąć verbs stay regular in both tenses.
+func TestTworzycFamily(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSg3M   string
+		wantSg3F   string
+		wantVN     string
+	}{
+		{"otworzyć", "otworzę", "otwarł", "otwarła", "otwarcie"},
+		{"roztworzyć", "roztworzę", "roztwarł", "roztwarła", "roztwarcie"},
+		{"przetworzyć", "przetworzę", "przetwarł", "przetwarła", "przetwarcie"},
+		{"tworzyć", "tworzę", "tworzył", "tworzyła", "tworzenie"},
+		{"stworzyć", "stworzę", "stworzył", "stworzyła", "stworzenie"},
+		{"zatworzyć", "zatworzę", "zatworzył", "zatworzyła", "zatworzenie"},
+		{"zamknąć", "zamknę", "zamknął", "zamknęła", "zamknięcie"},
+		{"przymknąć", "przymknę", "przymknął", "przymknęła", "przymknięcie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			present, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got := present[0].Sg1; got != tt.wantSg1 {
+				t.Errorf("ConjugatePresent(%q).Sg1 = %s, want %s", tt.infinitive, got, tt.wantSg1)
+			}
+
+			past, err := ConjugatePast(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePast(%q) error: %v", tt.infinitive, err)
+			}
+			if got := past[0]; got.Sg3M != tt.wantSg3M || got.Sg3F != tt.wantSg3F {
+				t.Errorf("ConjugatePast(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got.Sg3M, got.Sg3F, tt.wantSg3M, tt.wantSg3F)
+			}
+
+			vn, err := VerbalNoun(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q) error: %v", tt.infinitive, err)
+			}
+			if len(vn) != 1 || vn[0] != tt.wantVN {
+				t.Errorf("VerbalNoun(%q) = %v, want [%s]", tt.infinitive, vn, tt.wantVN)
+			}
+		})
+	}
+}
+
+// TestConjugatePresentRycRzyc checks that -ryć (hard r, j-insertion) and
+// -rzyć (soft rz, -ę/-ysz) verbs are not confused.
+func TestConjugatePresentRycRzyc(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSg2    string
+	}{
+		{"ryć", "ryję", "ryjesz"},
+		{"wyryć", "wyryję", "wyryjesz"},
+		{"zryć", "zryję", "zryjesz"},
+		{"rozryć", "rozryję", "rozryjesz"},
+		{"kryć", "kryję", "kryjesz"},
+		{"odkryć", "odkryję", "odkryjesz"},
+		{"burzyć", "burzę", "burzysz"},
+		{"tworzyć", "tworzę", "tworzysz"},
+		{"marzyć", "marzę", "marzysz"},
+		{"uderzyć", "uderzę", "uderzysz"},
+		{"wierzyć", "wierzę", "wierzysz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			paradigms, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got := paradigms[0]; got.Sg1 != tt.wantSg1 || got.Sg2 != tt.wantSg2 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got.Sg1, got.Sg2, tt.wantSg1, tt.wantSg2)
+			}
+		})
+	}
+}
+
+// TestRzecFamily checks the -trzeć/-drzeć/-przeć/-mrzeć/-wrzeć verbs: the
+// present drops z before -ę/-ą (zetrę) and keeps epenthetic and stacked
+// prefixes, while the past and verbal noun use the ar- stem (starł, starcie).
+func TestRzecFamily(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSg2    string
+		wantPast   string
+		wantVN     string
+	}{
+		{"zetrzeć", "zetrę", "zetrzesz", "starł", "starcie"},
+		{"wetrzeć", "wetrę", "wetrzesz", "wtarł", "wtarcie"},
+		{"odetrzeć", "odetrę", "odetrzesz", "odtarł", "odtarcie"},
+		{"rozpostrzeć", "rozpostrę", "rozpostrzesz", "rozpostarł", "rozpostarcie"},
+		{"zedrzeć", "zedrę", "zedrzesz", "zdarł", "zdarcie"},
+		{"wesprzeć", "wesprę", "wesprzesz", "wsparł", "wsparcie"},
+		{"odeprzeć", "odeprę", "odeprzesz", "odparł", "odparcie"},
+		{"umrzeć", "umrę", "umrzesz", "umarł", "umarcie"},
+		{"obumrzeć", "obumrę", "obumrzesz", "obumarł", "obumarcie"},
+		{"zawrzeć", "zawrę", "zawrzesz", "zawarł", "zawarcie"},
+		{"zaopatrzeć", "zaopatrzę", "zaopatrzysz", "zaopatrzał", "zaopatrzenie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			present, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got := present[0]; got.Sg1 != tt.wantSg1 || got.Sg2 != tt.wantSg2 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got.Sg1, got.Sg2, tt.wantSg1, tt.wantSg2)
+			}
+
+			past, err := ConjugatePast(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePast(%q) error: %v", tt.infinitive, err)
+			}
+			if got := past[0].Sg3M; got != tt.wantPast {
+				t.Errorf("ConjugatePast(%q).Sg3M = %s, want %s", tt.infinitive, got, tt.wantPast)
+			}
+
+			vn, err := VerbalNoun(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q) error: %v", tt.infinitive, err)
+			}
+			if vn[0] != tt.wantVN {
+				t.Errorf("VerbalNoun(%q) = %v, want %s first", tt.infinitive, vn, tt.wantVN)
+			}
+		})
+	}
+}
+
+// TestChciecFamily checks chcieć and its prefixed and reflexive compounds
+// across present, past and verbal noun. The epenthetic vowel of ze- and
+// ode- is kept in every tense.
+func TestChciecFamily(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSg3    string
+		wantSg3M   string
+		wantSg3N   string
+		wantVN     string
+	}{
+		{"chcieć", "chcę", "chce", "chciał", "chciało", "chcenie"},
+		{"zechcieć", "zechcę", "zechce", "zechciał", "zechciało", "zechcenie"},
+		{"zachcieć", "zachcę", "zachce", "zachciał", "zachciało", "zachcenie"},
+		{"odechcieć się", "odechcę się", "odechce się", "odechciał się", "odechciało się", "odechcenie się"},
+		{"zachcieć się", "zachcę się", "zachce się", "zachciał się", "zachciało się", "zachcenie się"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			present, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got := present[0]; got.Sg1 != tt.wantSg1 || got.Sg3 != tt.wantSg3 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got.Sg1, got.Sg3, tt.wantSg1, tt.wantSg3)
+			}
+
+			past, err := ConjugatePast(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePast(%q) error: %v", tt.infinitive, err)
+			}
+			if got := past[0]; got.Sg3M != tt.wantSg3M || got.Sg3N != tt.wantSg3N {
+				t.Errorf("ConjugatePast(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got.Sg3M, got.Sg3N, tt.wantSg3M, tt.wantSg3N)
+			}
+
+			vn, err := VerbalNoun(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q) error: %v", tt.infinitive, err)
+			}
+			if len(vn) != 1 || vn[0] != tt.wantVN {
+				t.Errorf("VerbalNoun(%q) = %v, want [%s]", tt.infinitive, vn, tt.wantVN)
+			}
+		})
+	}
+}
+
+func TestConjugatePresentExplained(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSource string
+	}{
+		{"robić", "robię", "heuristicIc"},
+		{"czytać", "czytam", "heuristicAc"},
+		{"być", "jestem", "irregular lookup"},
+		{"zjeść", "zjem", "prefix:z+base:jeść"},
+		{"stać", "stoję", "homograph"},
+		{"wysłać", "wyślę", "prefix:wy+base:słać (homograph)"},
+		{"bać się", "boję się", "irregular lookup"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresentExplained(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresentExplained(%q) error: %v", tt.infinitive, err)
+			}
+			if got[0].Sg1 != tt.wantSg1 || got[0].Source != tt.wantSource {
+				t.Errorf("ConjugatePresentExplained(%q) = %q from %q, want %q from %q",
+					tt.infinitive, got[0].Sg1, got[0].Source, tt.wantSg1, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestConjugatePresentReflexiveHomograph(t *testing.T) {
+	// Conjugating the reflexive must not append się to the shared
+	// homograph table.
+	if _, err := ConjugatePresent("stać się"); err != nil {
+		t.Fatal(err)
+	}
+	got, _ := ConjugatePresent("stać")
+	if got[0].Sg1 != "stoję" {
+		t.Errorf("ConjugatePresent(stać) after stać się = %q, want stoję", got[0].Sg1)
+	}
+}
+
+func TestConjugatePresentNacSoftening(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg2    string
+		wantPl3    string
+	}{
+		// s → ś, except after p, k, m
+		{"gasnąć", "gaśniesz", "gasną"},
+		{"przysnąć", "przyśniesz", "przysną"},
+		{"kuksnąć", "kuksniesz", "kuksną"},
+		{"wypsnąć", "wypsniesz", "wypsną"},
+		// z → ź after a vowel
+		{"liznąć", "liźniesz", "lizną"},
+		{"grzęznąć", "grzęźniesz", "grzęzną"},
+		{"maznąć", "maźniesz", "mazną"},
+		{"bryznąć", "bryźniesz", "bryzną"},
+		{"wiąznąć", "wiąźniesz", "wiązną"},
+		// but not in rz, łz
+		{"marznąć", "marzniesz", "marzną"},
+		{"pełznąć", "pełzniesz", "pełzną"},
+		// other consonants and vowels are unchanged
+		{"garbnąć", "garbniesz", "garbną"},
+		{"kwitnąć", "kwitniesz", "kwitną"},
+		{"chłonąć", "chłoniesz", "chłoną"},
+		{"tonąć", "toniesz", "toną"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got[0].Sg2 != tt.wantSg2 || got[0].Pl3 != tt.wantPl3 {
+				t.Errorf("ConjugatePresent(%q) = %s ... %s, want %s ... %s",
+					tt.infinitive, got[0].Sg2, got[0].Pl3, tt.wantSg2, tt.wantPl3)
+			}
+		})
+	}
+}
+
+// TestConjugatePresentSnac checks the -snąć family: hard -sn- in sg1 and
+// pl3 (rosnę, rosną) against softened -śn- in the other persons
+// (rośniesz), with rość sharing the present of rosnąć.
+func TestConjugatePresentSnac(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       PresentTense
+	}{
+		{"rosnąć", PresentTense{"rosnę", "rośniesz", "rośnie", "rośniemy", "rośniecie", "rosną"}},
+		{"wyrosnąć", PresentTense{"wyrosnę", "wyrośniesz", "wyrośnie", "wyrośniemy", "wyrośniecie", "wyrosną"}},
+		{"rość", PresentTense{"rosnę", "rośniesz", "rośnie", "rośniemy", "rośniecie", "rosną"}},
+		{"urość", PresentTense{"urosnę", "urośniesz", "urośnie", "urośniemy", "urośniecie", "urosną"}},
+		{"przysnąć", PresentTense{"przysnę", "przyśniesz", "przyśnie", "przyśniemy", "przyśniecie", "przysną"}},
+		{"usnąć", PresentTense{"usnę", "uśniesz", "uśnie", "uśniemy", "uśniecie", "usną"}},
+		{"zasnąć", PresentTense{"zasnę", "zaśniesz", "zaśnie", "zaśniemy", "zaśniecie", "zasną"}},
+		{"trzasnąć", PresentTense{"trzasnę", "trzaśniesz", "trzaśnie", "trzaśniemy", "trzaśniecie", "trzasną"}},
+		{"błysnąć", PresentTense{"błysnę", "błyśniesz", "błyśnie", "błyśniemy", "błyśniecie", "błysną"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if !got[0].PresentTense.Equals(tt.want) {
+				t.Errorf("ConjugatePresent(%q) = %v, want %v", tt.infinitive, got[0].PresentTense, tt.want)
+			}
+		})
+	}
+}
+
+func TestConjugatePresentEcTable(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSg2    string
+	}{
+		// action verbs, class IIa and IIb
+		{"grzmieć", "grzmię", "grzmisz"},
+		{"zagrzmieć", "zagrzmię", "zagrzmisz"},
+		{"cierpieć", "cierpię", "cierpisz"},
+		{"wycierpieć", "wycierpię", "wycierpisz"},
+		{"tkwieć", "tkwię", "tkwisz"},
+		{"śmierdzieć", "śmierdzę", "śmierdzisz"},
+		{"skomleć", "skomlę", "skomlisz"},
+		{"patrzeć", "patrzę", "patrzysz"},
+		{"zaopatrzeć", "zaopatrzę", "zaopatrzysz"},
+		{"usłyszeć", "usłyszę", "usłyszysz"},
+		// inchoatives, class I in -eję
+		{"starzeć", "starzeję", "starzejesz"},
+		{"zestarzeć", "zestarzeję", "zestarzejesz"},
+		{"dojrzeć", "dojrzeję", "dojrzejesz"},
+		{"dorośleć", "dorośleję", "doroślejesz"},
+		{"tężeć", "tężeję", "tężejesz"},
+		{"śniedzieć", "śniedzieję", "śniedziejesz"},
+		{"źreć", "źreję", "źrejesz"},
+		{"zeźreć", "zeźreję", "zeźrejesz"},
+		{"szlachcieć", "szlachcieję", "szlachciejesz"},
+		// outside the tables
+		{"chcieć", "chcę", "chcesz"},
+		{"odechcieć", "odechcę", "odechcesz"},
+		{"wejźrzeć", "wejźrzę", "wejźrzysz"},
+		{"wyźrzeć", "wyźrzę", "wyźrzysz"},
+		{"zeźrzeć", "zeźrzę", "zeźrzysz"},
+		{"współcierpieć", "współcierpię", "współcierpisz"},
+		{"umieć", "umiem", "umiesz"},
+		{"leżeć", "leżę", "leżysz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got[0].Sg1 != tt.wantSg1 || got[0].Sg2 != tt.wantSg2 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got[0].Sg1, got[0].Sg2, tt.wantSg1, tt.wantSg2)
+			}
+		})
+	}
+}
+
+func TestConjugatePresentNasalAc(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSg3    string
+	}{
+		// -m- after d
+		{"dąć", "dmę", "dmie"},
+		{"nadąć", "nadmę", "nadmie"},
+		{"odąć", "odmę", "odmie"},
+		{"wzdąć", "wzdmę", "wzdmie"},
+		// -n- elsewhere
+		{"żąć", "żnę", "żnie"},
+		{"wyżąć", "wyżnę", "wyżnie"},
+		{"miąć", "mnę", "mnie"},
+		// e after a consonant-final prefix
+		{"zmiąć", "zemnę", "zemnie"},
+		{"zżąć", "zeżnę", "zeżnie"},
+		{"wżąć", "weżnę", "weżnie"},
+		{"zdąć", "zedmę", "zedmie"},
+		{"rozdąć", "rozedmę", "rozedmie"},
+		{"zjąć", "zejmę", "zejmie"},
+		// the irregular table still wins, with the same e
+		{"piąć", "pnę", "pnie"},
+		{"wspiąć", "wespnę", "wespnie"},
+		{"zapiąć", "zapnę", "zapnie"},
+		{"rozpiąć", "rozepnę", "rozepnie"},
+		{"spiąć", "zepnę", "zepnie"},
+		{"odpiąć", "odepnę", "odepnie"},
+		{"rozciąć", "rozetnę", "rozetnie"},
+		{"zgiąć", "zegnę", "zegnie"},
+		{"rozjąć", "rozejmę", "rozejmie"},
+		{"skląć", "sklnę", "sklnie"},
+		// so do the specific heuristics
+		{"ciąć", "tnę", "tnie"},
+		{"giąć", "gnę", "gnie"},
+		{"zacząć", "zacznę", "zacznie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got[0].Sg1 != tt.wantSg1 || got[0].Sg3 != tt.wantSg3 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got[0].Sg1, got[0].Sg3, tt.wantSg1, tt.wantSg3)
+			}
+		})
+	}
+}
+
+// TestHeuristicNasalAcMatchesTable checks the heuristic alone against
+// prefixed verbs the irregular table and its prefix expansion cover, so it
+// resolves novel prefixed forms the same way.
+func TestHeuristicNasalAcMatchesTable(t *testing.T) {
+	for _, infinitive := range []string{
+		"zapiąć", "przypiąć", "odpiąć", "rozpiąć", "zmiąć",
+		"przyjąć", "zająć", "zdjąć", "objąć", "podjąć", "odjąć", "nająć",
+	} {
+		t.Run(infinitive, func(t *testing.T) {
+			want, err := ConjugatePresent(infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", infinitive, err)
+			}
+			got, ok := heuristicNasalAc(infinitive)
+			if !ok || !got.Equals(want[0].PresentTense) {
+				t.Errorf("heuristicNasalAc(%q) = %v, %v; want %v", infinitive, got, ok, want[0].PresentTense)
+			}
+		})
+	}
+
+	if _, ok := heuristicNasalAc("wziąć"); ok {
+		t.Error("heuristicNasalAc(wziąć) matched; the wziąć family is suppletive")
+	}
+}
+
+func TestConjugatePastVelarC(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1M   string
+		wantSg3M   string
+		wantPl3V   string
+	}{
+		// -óc: ó only in sg3m
+		{"móc", "mogłem", "mógł", "mogli"},
+		{"pomóc", "pomogłem", "pomógł", "pomogli"},
+		{"wymóc", "wymogłem", "wymógł", "wymogli"},
+		// -ec with g in the present
+		{"strzec", "strzegłem", "strzegł", "strzegli"},
+		{"biec", "biegłem", "biegł", "biegli"},
+		{"zapobiec", "zapobiegłem", "zapobiegł", "zapobiegli"},
+		// -ec with k in the present
+		{"piec", "piekłem", "piekł", "piekli"},
+		{"rzec", "rzekłem", "rzekł", "rzekli"},
+		{"ciec", "ciekłem", "ciekł", "ciekli"},
+		// -uc
+		{"tłuc", "tłukłem", "tłukł", "tłukli"},
+		// no present: k by default
+		{"przesiąc", "przesiąkłem", "przesiąkł", "przesiąkli"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePast(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePast(%q) error: %v", tt.infinitive, err)
+			}
+			p := got[0]
+			if p.Sg1M != tt.wantSg1M || p.Sg3M != tt.wantSg3M || p.Pl3V != tt.wantPl3V {
+				t.Errorf("ConjugatePast(%q) = %s, %s, %s; want %s, %s, %s",
+					tt.infinitive, p.Sg1M, p.Sg3M, p.Pl3V, tt.wantSg1M, tt.wantSg3M, tt.wantPl3V)
+			}
+		})
+	}
+}
+
+func TestConjugatePresentTacAlternation(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       []PresentTense
+	}{
+		// t→cz throughout, with a regular -am variant
+		{"chichotać", []PresentTense{
+			{Sg1: "chichoczę", Sg2: "chichoczesz", Sg3: "chichocze", Pl1: "chichoczemy", Pl2: "chichoczecie", Pl3: "chichoczą"},
+			{Sg1: "chichotam", Sg2: "chichotasz", Sg3: "chichota", Pl1: "chichotamy", Pl2: "chichotacie", Pl3: "chichotają"},
+		}},
+		{"bełkotać", []PresentTense{
+			{Sg1: "bełkoczę", Sg2: "bełkoczesz", Sg3: "bełkocze", Pl1: "bełkoczemy", Pl2: "bełkoczecie", Pl3: "bełkoczą"},
+			{Sg1: "bełkotam", Sg2: "bełkotasz", Sg3: "bełkota", Pl1: "bełkotamy", Pl2: "bełkotacie", Pl3: "bełkotają"},
+		}},
+		// t→cz only
+		{"szeptać", []PresentTense{
+			{Sg1: "szepczę", Sg2: "szepczesz", Sg3: "szepcze", Pl1: "szepczemy", Pl2: "szepczecie", Pl3: "szepczą"},
+		}},
+		{"deptać", []PresentTense{
+			{Sg1: "depczę", Sg2: "depczesz", Sg3: "depcze", Pl1: "depczemy", Pl2: "depczecie", Pl3: "depczą"},
+		}},
+		// motać is regular
+		{"motać", []PresentTense{
+			{Sg1: "motam", Sg2: "motasz", Sg3: "mota", Pl1: "motamy", Pl2: "motacie", Pl3: "motają"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ConjugatePresent(%q) returned %d paradigms, want %d", tt.infinitive, len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if got[i].PresentTense != want {
+					t.Errorf("ConjugatePresent(%q)[%d] = %+v, want %+v", tt.infinitive, i, got[i].PresentTense, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConjugatePresentChowac(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantPl3    string
+	}{
+		// chować keeps -ow- and takes -am, bare or prefixed
+		{"chować", "chowam", "chowają"},
+		{"wychować", "wychowam", "wychowają"},
+		{"zachować", "zachowam", "zachowają"},
+		{"pochować", "pochowam", "pochowają"},
+		{"schować", "schowam", "schowają"},
+		{"przechować", "przechowam", "przechowają"},
+		{"zachować się", "zachowam się", "zachowają się"},
+		// other -chować verbs take -uję
+		{"szachować", "szachuję", "szachują"},
+		{"zuchować", "zuchuję", "zuchują"},
+		{"rachować", "rachuję", "rachują"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got[0].Sg1 != tt.wantSg1 || got[0].Pl3 != tt.wantPl3 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got[0].Sg1, got[0].Pl3, tt.wantSg1, tt.wantPl3)
+			}
+		})
+	}
+}
+
+func TestConjugatePresentKlascKrasc(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSg2    string
+		wantPl3    string
+	}{
+		{"kłaść", "kładę", "kładziesz", "kładą"},
+		{"nakłaść", "nakładę", "nakładziesz", "nakładą"},
+		{"pokłaść", "pokładę", "pokładziesz", "pokładą"},
+		{"ukłaść", "układę", "układziesz", "układą"},
+		{"kraść", "kradnę", "kradniesz", "kradną"},
+		{"podkraść", "podkradnę", "podkradniesz", "podkradną"},
+		{"ukraść", "ukradnę", "ukradniesz", "ukradną"},
+		{"wykraść", "wykradnę", "wykradniesz", "wykradną"},
+		{"porozkraść", "porozkradnę", "porozkradniesz", "porozkradną"},
+		{"zakraść się", "zakradnę się", "zakradniesz się", "zakradną się"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got[0].Sg1 != tt.wantSg1 || got[0].Sg2 != tt.wantSg2 || got[0].Pl3 != tt.wantPl3 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s, %s; want %s, %s, %s", tt.infinitive,
+					got[0].Sg1, got[0].Sg2, got[0].Pl3, tt.wantSg1, tt.wantSg2, tt.wantPl3)
+			}
+		})
+	}
+}
+
+func TestConjugatePresentWiedziec(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       PresentTense
+	}{
+		{"wiedzieć", PresentTense{Sg1: "wiem", Sg2: "wiesz", Sg3: "wie", Pl1: "wiemy", Pl2: "wiecie", Pl3: "wiedzą"}},
+		{"powiedzieć", PresentTense{Sg1: "powiem", Sg2: "powiesz", Sg3: "powie", Pl1: "powiemy", Pl2: "powiecie", Pl3: "powiedzą"}},
+		{"dowiedzieć się", PresentTense{Sg1: "dowiem się", Sg2: "dowiesz się", Sg3: "dowie się", Pl1: "dowiemy się", Pl2: "dowiecie się", Pl3: "dowiedzą się"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if !got[0].PresentTense.Equals(tt.want) {
+				t.Errorf("ConjugatePresent(%q) = %+v, want %+v", tt.infinitive, got[0].PresentTense, tt.want)
+			}
+		})
+	}
+
+	// Every attested prefixed form keeps wiedz- in pl3
+	for _, infinitive := range []string{
+		"dopowiedzieć", "dowiedzieć", "odpowiedzieć", "opowiedzieć",
+		"podpowiedzieć", "przepowiedzieć", "przewiedzieć", "przypowiedzieć",
+		"rozpowiedzieć", "rozwiedzieć", "wwiedzieć", "wypowiedzieć",
+		"wywiedzieć", "zapowiedzieć", "zwiedzieć",
+	} {
+		prefix := strings.TrimSuffix(infinitive, "wiedzieć")
+		got, err := ConjugatePresent(infinitive)
+		if err != nil {
+			t.Errorf("ConjugatePresent(%q) error: %v", infinitive, err)
+			continue
+		}
+		if got[0].Sg1 != prefix+"wiem" || got[0].Pl3 != prefix+"wiedzą" {
+			t.Errorf("ConjugatePresent(%q) = %s, %s; want %swiem, %swiedzą",
+				infinitive, got[0].Sg1, got[0].Pl3, prefix, prefix)
+		}
+	}
+}
+
+func TestConjugatePresentAlternatingAc(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		wantSg1    string
+		wantSg3    string
+	}{
+		// listed stems
+		{"pisać", "piszę", "pisze"},
+		{"kazać", "każę", "każe"},
+		{"skakać", "skaczę", "skacze"},
+		{"karać", "karzę", "karze"},
+		{"orać", "orzę", "orze"},
+		{"łgać", "łżę", "łże"},
+		{"łamać", "łamię", "łamie"},
+		{"kłamać", "kłamię", "kłamie"},
+		{"drzemać", "drzemię", "drzemie"},
+		// prefixed forms share the base's entry
+		{"opisać", "opiszę", "opisze"},
+		{"wskazać", "wskażę", "wskaże"},
+		{"przekazać", "przekażę", "przekaże"},
+		{"zaorać", "zaorzę", "zaorze"},
+		{"złamać", "złamię", "złamie"},
+		{"okłamać", "okłamię", "okłamie"},
+		{"zdrzemać", "zdrzemię", "zdrzemie"},
+		// unlisted stems stay regular
+		{"czytać", "czytam", "czyta"},
+		{"kasać", "kasam", "kasa"},
+		{"szukać", "szukam", "szuka"},
+		{"trzymać", "trzymam", "trzyma"},
+		{"zatrzymać", "zatrzymam", "zatrzyma"},
+		{"dumać", "dumam", "duma"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := ConjugatePresent(tt.infinitive)
+			if err != nil {
+				t.Fatalf("ConjugatePresent(%q) error: %v", tt.infinitive, err)
+			}
+			if got[0].Sg1 != tt.wantSg1 || got[0].Sg3 != tt.wantSg3 {
+				t.Errorf("ConjugatePresent(%q) = %s, %s; want %s, %s",
+					tt.infinitive, got[0].Sg1, got[0].Sg3, tt.wantSg1, tt.wantSg3)
+			}
+		})
+	}
+}
+
+func TestAppendParadigmMergesDuplicates(t *testing.T) {
+	fall := PresentTense{Sg1: "padnę", Sg2: "padniesz", Sg3: "padnie", Pl1: "padniemy", Pl2: "padniecie", Pl3: "padną"}
+	graze := PresentTense{Sg1: "pasę", Sg2: "pasiesz", Sg3: "pasie", Pl1: "pasiemy", Pl2: "pasiecie", Pl3: "pasą"}
+
+	tests := []struct {
+		name string
+		in   []Paradigm
+		want []Paradigm
+	}{
+		{
+			"distinct forms stay apart",
+			[]Paradigm{{PresentTense: fall, Gloss: "to fall"}, {PresentTense: graze, Gloss: "to graze"}},
+			[]Paradigm{{PresentTense: fall, Gloss: "to fall"}, {PresentTense: graze, Gloss: "to graze"}},
+		},
+		{
+			"a variant collapses into the first",
+			[]Paradigm{{PresentTense: fall, Gloss: "to fall"}, {PresentTense: fall, Gloss: "to fall (variant)"}},
+			[]Paradigm{{PresentTense: fall, Gloss: "to fall"}},
+		},
+		{
+			"distinct glosses are combined",
+			[]Paradigm{{PresentTense: fall, Gloss: "to fall"}, {PresentTense: fall, Gloss: "to graze"}},
+			[]Paradigm{{PresentTense: fall, Gloss: "to fall; to graze"}},
+		},
+		{
+			"an empty gloss takes the other",
+			[]Paradigm{{PresentTense: fall}, {PresentTense: fall, Gloss: "to fall"}},
+			[]Paradigm{{PresentTense: fall, Gloss: "to fall"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Paradigm
+			for _, p := range tt.in {
+				got = appendParadigm(got, p)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	past := []PastParadigm{{PastTense: PastTense{Sg3M: "padł"}, Gloss: "to fall"}}
+	past = appendPastParadigm(past, PastParadigm{PastTense: PastTense{Sg3M: "padł"}, Gloss: "to fall (variant)"})
+	if len(past) != 1 || past[0].Gloss != "to fall" {
+		t.Errorf("appendPastParadigm = %+v, want one paradigm glossed to fall", past)
+	}
+}
+
+func TestConjugateNoDuplicateParadigms(t *testing.T) {
+	// homographs and dual-form verbs, bare and prefixed
+	verbs := []string{
+		"stać", "paść", "spaść", "wypaść", "przepaść się",
+		"pełznąć", "wpełznąć", "trzasnąć", "kwitnąć", "chichotać",
+	}
+	for _, inf := range verbs {
+		present, _ := ConjugatePresent(inf)
+		for i := range present {
+			for j := i + 1; j < len(present); j++ {
+				if present[i].PresentTense.Equals(present[j].PresentTense) {
+					t.Errorf("ConjugatePresent(%q) repeats paradigm %d as %d", inf, i, j)
+				}
+			}
+		}
+		past, _ := ConjugatePast(inf)
+		for i := range past {
+			for j := i + 1; j < len(past); j++ {
+				if past[i].PastTense.Equals(past[j].PastTense) {
+					t.Errorf("ConjugatePast(%q) repeats paradigm %d as %d", inf, i, j)
+				}
+			}
+		}
+	}
+}
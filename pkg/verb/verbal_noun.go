@@ -1,7 +1,7 @@
 package verb
 
 import (
-	"fmt"
+	"slices"
 	"strings"
 	"unicode/utf8"
 )
@@ -10,6 +10,28 @@ import (
 // verb infinitive. Returns a slice because some verbs have multiple valid forms.
 // Examples: czytać → ["czytanie"], pić → ["picie"], ciec → ["cieczenie", "cieknięcie"]
 func VerbalNoun(infinitive string) ([]string, error) {
+	infinitive, capital := normalizeInfinitive(infinitive)
+	forms, err := verbalNoun(infinitive)
+	if capital {
+		forms = slices.Clone(forms) // irregular forms come straight from the table
+		for i := range forms {
+			forms[i] = capitalize(forms[i])
+		}
+	}
+	return forms, err
+}
+
+// verbalNoun is VerbalNoun for a normalized infinitive.
+func verbalNoun(infinitive string) ([]string, error) {
+	if err := checkInfinitive(infinitive); err != nil {
+		return nil, err
+	}
+
+	// 0. Reflexive verbs keep się: śmiać się → śmianie się
+	if base, ok := splitReflexive(infinitive); ok {
+		return verbalNounReflexive(base)
+	}
+
 	// 1. Check irregular lookup (with prefix support)
 	if forms, prefix, ok := lookupIrregularVN(infinitive); ok {
 		if prefix != "" {
@@ -29,7 +51,7 @@ func VerbalNoun(infinitive string) ([]string, error) {
 		return verbalNounNac(infinitive), nil
 	}
 
-	// 4. Non-nąć -ąć → -ęcie
+	// 4. Non-nąć -ąć → -ęcie; -iąć keeps its i: giąć → gięcie, dąć → dęcie
 	if strings.HasSuffix(infinitive, "ąć") {
 		stem := strings.TrimSuffix(infinitive, "ąć")
 		return []string{stem + "ęcie"}, nil
@@ -56,22 +78,95 @@ func VerbalNoun(infinitive string) ([]string, error) {
 		return verbalNounEc(infinitive), nil
 	}
 
-	// 9. -c / -ść / -źć → should have been caught by irregular lookup
-	return nil, fmt.Errorf("cannot derive verbal noun for %q", infinitive)
+	// 9. -c → from the present stem (piec → pieczesz → pieczenie)
+	if strings.HasSuffix(infinitive, "c") {
+		if forms, ok := verbalNounC(infinitive); ok {
+			return forms, nil
+		}
+	}
+
+	// 10. -ść / -źć → should have been caught by irregular lookup
+	return nil, &ConjugationError{Infinitive: infinitive, Form: "verbal noun"}
+}
+
+// verbalNounC derives the verbal noun of a -c verb from its present stem.
+//   - n-inserting verbs: biec → biegnę → biegnięcie
+//   - k/g stems, read off the softened 2sg: piec → pieczesz → pieczenie,
+//     móc → możesz → możenie, strzec → strzeżesz → strzeżenie
+//
+// Verbs with more than one attested form (ciec → cieczenie, cieknięcie)
+// stay in irregularVerbalNouns.
+func verbalNounC(infinitive string) ([]string, bool) {
+	paradigms, err := ConjugatePresent(infinitive)
+	if err != nil {
+		return nil, false
+	}
+	p := paradigms[0]
+	if stem, ok := strings.CutSuffix(p.Sg1, "nę"); ok && strings.HasSuffix(p.Sg2, "niesz") {
+		return []string{stem + "nięcie"}, true
+	}
+	if stem, ok := strings.CutSuffix(p.Sg2, "esz"); ok {
+		return []string{stem + "enie"}, true
+	}
+	return nil, false
+}
+
+// VerbalNounPlural returns the nominative plural of the verbal noun.
+// Verbal nouns are neuter -nie/-cie nouns, so the plural ends in -nia/-cia.
+// Examples: marzyć → ["marzenia"], ćwiczyć → ["ćwiczenia"]
+//
+// Many verbal nouns are used only in the singular (czytanie, bieganie);
+// this returns the grammatically possible form, not an attested one.
+func VerbalNounPlural(infinitive string) ([]string, error) {
+	forms, err := VerbalNoun(infinitive)
+	if err != nil {
+		return nil, err
+	}
+	plurals := make([]string, len(forms))
+	for i, f := range forms {
+		plurals[i] = strings.TrimSuffix(f, "e") + "a"
+	}
+	return plurals, nil
 }
 
 // verbalNounNac handles -nąć verbs: strip -nąć, soften before ń, add -nięcie.
+// Dual-form verbs (see isDualFormNacVerb), whose past has both an n-kept and
+// an n-dropped paradigm, also get their second verbal noun: see
+// verbalNounNacVariant.
 func verbalNounNac(infinitive string) []string {
 	stem := strings.TrimSuffix(infinitive, "nąć")
-	softStem := softenBeforeNForVN(stem)
-	return []string{softStem + "nięcie"}
+	softStem := softenBeforeN(stem)
+	forms := []string{softStem + "nięcie"}
+	if isDualFormNacVerb(infinitive) {
+		forms = append(forms, verbalNounNacVariant(stem, softStem))
+	}
+	return forms
 }
 
-// softenBeforeNForVN softens the final consonant of a stem before ń
-// in verbal noun derivation.
+// verbalNounNacVariant returns the second verbal noun of a dual-form -nąć
+// verb. A stem whose s or z may or may not soften before ń gets the other
+// spelling: pełznięcie → pełźnięcie, trzaśnięcie → trzasnięcie. Any other
+// stem gets the -nienie noun of the n-dropping state verbs: kwitnięcie →
+// kwitnienie, gęstnięcie → gęstnienie.
+func verbalNounNacVariant(stem, softStem string) string {
+	switch {
+	case softStem != stem:
+		return stem + "nięcie"
+	case strings.HasSuffix(stem, "łz"):
+		return strings.TrimSuffix(stem, "z") + "źnięcie"
+	}
+	return stem + "nienie"
+}
+
+// softenBeforeN softens the final consonant of a -nąć stem (without the
+// n) before a soft n, as in the present (-niesz) and the verbal noun
+// (-nięcie):
 //   - s → ś unless preceded by p, k, or m (ps, ks, ms clusters don't soften)
 //   - z → ź unless z is part of rz, cz, or łz cluster
-func softenBeforeNForVN(stem string) string {
+//
+// marznąć → marzniesz and pełznąć → pełzniesz keep their z; maznąć →
+// maźniesz, bryznąć → bryźniesz and liznąć → liźniesz soften it.
+func softenBeforeN(stem string) string {
 	if strings.HasSuffix(stem, "s") {
 		if len(stem) >= 2 {
 			before := stem[len(stem)-2]
@@ -102,10 +197,9 @@ func verbalNounIc(infinitive string) []string {
 		return []string{stem + "jenie"}
 	}
 
-	// Short stems (monosyllabic with a vowel): stem + icie
-	// Consonant-only clusters like ćm, kp, tl are NOT monosyllabic
-	runeCount := utf8.RuneCountInString(stem)
-	if runeCount <= 2 && containsVowel(stem) {
+	// Monosyllabic roots (optionally prefixed): stem + icie
+	// pić → picie, zbić → zbicie, spowić → spowicie
+	if isMonosyllabicIcStem(stem) {
 		return []string{stem + "icie"}
 	}
 
@@ -129,6 +223,21 @@ func verbalNounIc(infinitive string) []string {
 	return []string{stem + "ienie"}
 }
 
+// isMonosyllabicIcStem reports whether an -ić stem is one of the bare roots
+// b, p, w (bić, pić, wić), optionally behind a single prefix: zbić, wzbić, zapić.
+// Consonant clusters (kp, tl, śn, ćm) are NOT roots of this kind and take
+// -enie; gnić → gnicie is the lone cluster exception and stays irregular.
+// Only one prefix is allowed so that zdobić, sposobić and owdowić
+// are not misread as z+do+bić, s+po+so+bić, o+w+do+wić.
+func isMonosyllabicIcStem(stem string) bool {
+	last, size := utf8.DecodeLastRuneInString(stem)
+	if last != 'b' && last != 'p' && last != 'w' {
+		return false
+	}
+	prefix := stem[:len(stem)-size]
+	return prefix == "" || slices.Contains(verbPrefixes, prefix)
+}
+
 // verbalNounYc handles -yć verbs.
 func verbalNounYc(infinitive string) []string {
 	stem := strings.TrimSuffix(infinitive, "yć")
@@ -207,6 +316,12 @@ func containsVowel(s string) bool {
 }
 
 // irregularVerbalNouns maps infinitives to their verbal noun form(s).
+//
+// There are no -ać entries: stem + anie holds for every -ać verb in the corpus,
+// including j-stems (krajać → krajanie) and verbs whose present tense softens
+// (pisać → piszę but pisanie). The only other attested forms are ścielenie
+// and its prefixed variants, which belong to the słać "to spread (bedding)"
+// homograph (present ścielę) and are not generated.
 var irregularVerbalNouns = map[string][]string{
 	// -rzeć → -arcie family (dual forms: warcie from rzeć-stem, wrzenie from plain -eć)
 	"drzeć": {"darcie"},
@@ -234,22 +349,17 @@ var irregularVerbalNouns = map[string][]string{
 	// susnąć — irregular (doesn't soften s→ś)
 	"susnąć": {"susnięcie"},
 
-	// Monosyllabic -ić base verbs
-	"bić": {"bicie"}, "gnić": {"gnicie"}, "pić": {"picie"}, "wić": {"wicie"},
+	// gnić — consonant cluster root that still takes -icie (cf. kpić → kpienie)
+	"gnić": {"gnicie"},
+
+	// powić — po+wić fused into a root of its own, so spowić, opowić and
+	// rozpowić keep -icie through prefix stripping
+	"powić": {"powicie"},
 
 	// Monosyllabic -yć base verbs
 	"być": {"bycie"}, "żyć": {"życie"}, "myć": {"mycie"}, "ryć": {"rycie"},
 	"szyć": {"szycie"}, "kryć": {"krycie"}, "wyć": {"wycie"}, "tyć": {"tycie"},
 
-	// powić — powicie (not powienie; po+wić, keeps monosyllabic -icie ending)
-	"powić": {"powicie"},
-
-	// gzić — irregular z→ż (not caught by cluster rule)
-	"gzić": {"gżenie"},
-
-	// śnić — irregular (śnienie, not śnicie despite short stem)
-	"śnić": {"śnienie"},
-
 	// czcić/chrzcić — c→cz softening in rzc cluster
 	"czcić":   {"czczenie"},
 	"chrzcić": {"chrzczenie"},
@@ -279,17 +389,10 @@ var irregularVerbalNouns = map[string][]string{
 	"chrzęścieć": {"chrzęszczenie"},
 
 	// -c verbs (present-tense stem based)
-	"biec":   {"biegnięcie"},
 	"ciec":   {"cieczenie", "cieknięcie"},
 	"lec":    {"legnięcie", "lężenie"},
 	"ląc":    {"lęgnięcie", "lęknięcie", "lężenie"},
-	"móc":    {"możenie"},
-	"piec":   {"pieczenie"},
-	"rzec":   {"rzeczenie"},
-	"siec":   {"sieczenie"},
-	"strzec": {"strzeżenie"},
 	"strzyc": {"strzyżenie"},
-	"tłuc":   {"tłuczenie"},
 	"wlec":   {"wleczenie"},
 	"prząc":  {"przęgnięcie", "przężenie"},
 	"siąc":   {"sięgnięcie", "siężenie"},
@@ -332,19 +435,14 @@ var irregularVerbalNouns = map[string][]string{
 	"wieść":  {"wiedzenie"},
 	"żec":    {"żegnięcie", "żżenie"},
 	"wściec": {"wścieknięcie", "wścieczenie"},
-	"oblec":  {"obleczenie"},
 	"sieść":  {"siędnięcie"},
 
 	// Compound-prefix verbs (double/triple prefix base forms)
-	"pomóc":    {"pomożenie"},
-	"domóc":    {"domożenie"},
 	"naleźć":   {"nalezienie"},
 	"najść":    {"najście"},
 	"upaść":    {"upadnięcie"},
 	"podnieść": {"podniesienie"},
 	"przysiąc": {"przysięgnięcie", "przysiężenie"},
-	"niemóc":   {"niemożenie"},
-	"postrzec": {"postrzeżenie"},
 	"wsiąść":   {"wsiądnięcie"},
 	"strząść":  {"strzęsienie"},
 
@@ -368,16 +466,12 @@ var irregularVerbalNouns = map[string][]string{
 	"ściec":            {"ścieczenie", "ścieknięcie"},
 	"spostrzec":        {"spostrzeżenie"},
 	"złorzec":          {"złorzeczenie", "złorzeknięcie"},
-	"zapobiec":         {"zapobiegnięcie"},
-	"współubiec":       {"współubiegnięcie"},
 	"współposiąść":     {"współposiądnięcie"},
-	"wspomóc":          {"wspomożenie"},
 	"krzywoprzysiąc":   {"krzywoprzysięgnięcie", "krzywoprzysiężenie"},
 	"zaprzepaść":       {"zaprzepadnięcie"},
 	"nadojeść":         {"nadojedzenie"},
 	"półwisieć":        {"półwiszenie"},
 	"przesiąc":         {"przesiąknięcie"},
-	"niedomóc":         {"niedomożenie"},
 	"współżyć":         {"współżycie"},
 	"zbezeczcić":       {"zbezeczczenie"},
 	"zeźreć":           {"zziarcie"},
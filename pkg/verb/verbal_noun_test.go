@@ -0,0 +1,212 @@
+package verb
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestVerbalNounIcMonosyllabic(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       string
+	}{
+		// Bare and prefixed b/p/w roots: -icie
+		{"pić", "picie"},
+		{"bić", "bicie"},
+		{"wić", "wicie"},
+		{"zbić", "zbicie"},
+		{"wzbić", "wzbicie"},
+		{"opić", "opicie"},
+		{"zapić", "zapicie"},
+		{"spowić", "spowicie"},
+		{"gnić", "gnicie"},
+
+		// Consonant clusters: -enie
+		{"kpić", "kpienie"},
+		{"tlić", "tlenie"},
+		{"ćmić", "ćmienie"},
+		{"śnić", "śnienie"},
+		{"gzić", "gżenie"},
+
+		// Longer stems that merely end in b/p/w
+		{"zdobić", "zdobienie"},
+		{"sposobić", "sposobienie"},
+		{"owdowić", "owdowienie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := VerbalNoun(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q) error: %v", tt.infinitive, err)
+			}
+			if !slices.Contains(got, tt.want) {
+				t.Errorf("VerbalNoun(%q) = %v, want %q", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerbalNounPlural(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       []string
+	}{
+		{"marzyć", []string{"marzenia"}},
+		{"ćwiczyć", []string{"ćwiczenia"}},
+		{"zdjąć", []string{"zdjęcia"}},
+		{"czytać", []string{"czytania"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := VerbalNounPlural(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNounPlural(%q) error: %v", tt.infinitive, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("VerbalNounPlural(%q) = %v, want %v", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerbalNounAc(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       string
+	}{
+		{"czytać", "czytanie"},       // regular -am
+		{"krajać", "krajanie"},       // j-stem
+		{"śmiać", "śmianie"},         // vowel + ać
+		{"pisać", "pisanie"},         // present softens (piszę), VN does not
+		{"płakać", "płakanie"},       // k → cz in present only
+		{"wiązać", "wiązanie"},       // z → ż in present only
+		{"brać", "branie"},           // suppletive present (biorę)
+		{"dać", "danie"},             // monosyllabic
+		{"spać", "spanie"},           // -ij present (śpię)
+		{"dawać", "dawanie"},         // -awać
+		{"pokazywać", "pokazywanie"}, // -ywać
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := VerbalNoun(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q) error: %v", tt.infinitive, err)
+			}
+			if !slices.Equal(got, []string{tt.want}) {
+				t.Errorf("VerbalNoun(%q) = %v, want [%s]", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerbalNounC(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       string
+	}{
+		{"piec", "pieczenie"},          // k → cz
+		{"dopiec", "dopieczenie"},      // prefixed
+		{"móc", "możenie"},             // g → ż
+		{"pomóc", "pomożenie"},         // prefixed
+		{"strzec", "strzeżenie"},       // g → ż
+		{"tłuc", "tłuczenie"},          // k → cz
+		{"biec", "biegnięcie"},         // n-inserting present (biegnę)
+		{"nadbiec", "nadbiegnięcie"},   // prefixed
+		{"zapobiec", "zapobiegnięcie"}, // double prefix
+		{"ciec", "cieczenie"},          // curated: two attested forms
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := VerbalNoun(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q) error: %v", tt.infinitive, err)
+			}
+			if len(got) == 0 || got[0] != tt.want {
+				t.Errorf("VerbalNoun(%q) = %v, want %s first", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerbalNounIac(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       string
+	}{
+		{"giąć", "gięcie"}, // the stem keeps its i
+		{"zgiąć", "zgięcie"},
+		{"zagiąć", "zagięcie"},
+		{"piąć", "pięcie"},
+		{"wspiąć", "wspięcie"},
+		{"miąć", "mięcie"},
+		{"ciąć", "cięcie"},
+		{"dąć", "dęcie"}, // no i to keep
+		{"kląć", "klęcie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := VerbalNoun(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q) error: %v", tt.infinitive, err)
+			}
+			if !slices.Equal(got, []string{tt.want}) {
+				t.Errorf("VerbalNoun(%q) = %v, want [%s]", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerbalNounDualFormNac(t *testing.T) {
+	tests := []struct {
+		infinitive string
+		want       []string
+	}{
+		{"pełznąć", []string{"pełznięcie", "pełźnięcie"}}, // z softens or not
+		{"wypełznąć", []string{"wypełznięcie", "wypełźnięcie"}},
+		{"trzasnąć", []string{"trzaśnięcie", "trzasnięcie"}},
+		{"kwitnąć", []string{"kwitnięcie", "kwitnienie"}},
+		{"zniknąć", []string{"zniknięcie", "zniknienie"}},
+		{"ciągnąć", []string{"ciągnięcie"}}, // not dual-form
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.infinitive, func(t *testing.T) {
+			got, err := VerbalNoun(tt.infinitive)
+			if err != nil {
+				t.Fatalf("VerbalNoun(%q) error: %v", tt.infinitive, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("VerbalNoun(%q) = %v, want %v", tt.infinitive, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCorpusVerbalNounIac checks every -iąć verb in the corpus: giąć,
+// piąć, ciąć and their prefixed forms.
+func TestCorpusVerbalNounIac(t *testing.T) {
+	checked := 0
+	for _, e := range loadVerbalNounCorpus(t) {
+		if !strings.HasSuffix(e.Infinitive, "iąć") {
+			continue
+		}
+		checked++
+		got, err := VerbalNoun(e.Infinitive)
+		if err != nil {
+			t.Errorf("VerbalNoun(%q) error: %v", e.Infinitive, err)
+			continue
+		}
+		if !slices.Contains(got, e.VerbalNoun) {
+			t.Errorf("VerbalNoun(%q) = %v, want %s", e.Infinitive, got, e.VerbalNoun)
+		}
+	}
+	if checked == 0 {
+		t.Fatal("no -iąć verbs in the corpus")
+	}
+}